@@ -32,6 +32,8 @@ func validate(failFastMode bool, validator any) ValidationResult {
 	case []ValidationFunc:
 		vE := applyValidations(failFastMode, v...)
 		result.errors = append(result.errors, vE.errors...)
+	case Validatable:
+		result = validateValidatable(failFastMode, v)
 	default:
 		panic(fmt.Sprintf("Validate: unsupported type %T", v))
 	}
@@ -40,7 +42,7 @@ func validate(failFastMode bool, validator any) ValidationResult {
 }
 
 // Runs all validations and returns errors
-// It accepts ValidationFunc and/or []ValidationFunc and panics if other type is passed
+// It accepts ValidationFunc, []ValidationFunc and Validatable values and panics if other type is passed
 //
 // i.e. Validating a group and a composed
 //
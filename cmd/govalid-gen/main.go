@@ -0,0 +1,51 @@
+// govalid-gen generates reflection-free Validate methods from `validate` struct tags
+//
+// Usage:
+//
+//	//go:generate govalid-gen -type=Person,Order
+//
+// It writes <package>_validate_gen.go in the package directory, every struct with
+// at least one `validate` tag, or nesting one, gets a method
+//
+//	func (x *T) Validate() govalid.ValidationResult
+//
+// and a ValidatesNested marker method, see govalid.NestingValidatable
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Palma99/govalid/internal/codegen"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package to parse")
+	types := flag.String("type", "", "comma-separated list of type names, defaults to all tagged structs")
+	output := flag.String("output", "", "output file name, defaults to <package>_validate_gen.go")
+	flag.Parse()
+
+	var typeNames []string
+	if *types != "" {
+		typeNames = strings.Split(*types, ",")
+	}
+
+	src, pkgName, err := codegen.Generate(*dir, typeNames)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "govalid-gen: %v\n", err)
+		os.Exit(1)
+	}
+
+	outputPath := *output
+	if outputPath == "" {
+		outputPath = filepath.Join(*dir, pkgName+"_validate_gen.go")
+	}
+
+	if err := os.WriteFile(outputPath, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "govalid-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
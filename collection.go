@@ -0,0 +1,116 @@
+package govalid
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Applies the rules to every element of a slice, errors are reported on the
+// element path, i.e. "tags[2]"
+// Validating an object created with Each will return all errors
+//
+//	govalid.Each("tags", post.Tags,
+//		validators.NonEmptyRule(),
+//		validators.MaxLengthRule(20),
+//	)
+func Each[T any](fieldName string, values []T, rules ...ValidationRule) []ValidationFunc {
+	path := ParsePath(fieldName)
+
+	funcs := make([]ValidationFunc, 0, len(values)*len(rules))
+	for i, value := range values {
+		elementPath := path.Append(IndexSegment(i))
+		for _, rule := range rules {
+			funcs = append(funcs, withPath(elementPath, rule(elementPath.String(), value)))
+		}
+	}
+	return funcs
+}
+
+// Applies the rules to every element of a slice
+// Validating an object created with EachShortCircuit will return the first error
+func EachShortCircuit[T any](fieldName string, values []T, rules ...ValidationRule) ValidationFunc {
+	return ComposeShortCircuit(Each(fieldName, values, rules...))
+}
+
+// Applies typed rules to every element of a slice
+// Validating an object created with EachOf will return all errors
+func EachOf[T any](fieldName string, values []T, rules ...TypedRule[T]) []ValidationFunc {
+	path := ParsePath(fieldName)
+
+	funcs := make([]ValidationFunc, 0, len(values)*len(rules))
+	for i, value := range values {
+		elementPath := path.Append(IndexSegment(i))
+		for _, rule := range rules {
+			funcs = append(funcs, withPath(elementPath, rule(elementPath.String(), value)))
+		}
+	}
+	return funcs
+}
+
+// Applies the rules to every key of a map, errors are reported on the key path,
+// i.e. "labels[env]". Keys are visited in sorted order
+// Validating an object created with EachKey will return all errors
+func EachKey[K comparable, V any](fieldName string, m map[K]V, rules ...ValidationRule) []ValidationFunc {
+	return eachEntry(fieldName, m, rules, func(key K, _ V) any { return key })
+}
+
+// Applies the rules to every key of a map
+// Validating an object created with EachKeyShortCircuit will return the first error
+func EachKeyShortCircuit[K comparable, V any](fieldName string, m map[K]V, rules ...ValidationRule) ValidationFunc {
+	return ComposeShortCircuit(EachKey(fieldName, m, rules...))
+}
+
+// Applies the rules to every value of a map, errors are reported on the key path,
+// i.e. "labels[env]". Keys are visited in sorted order
+// Validating an object created with EachValue will return all errors
+func EachValue[K comparable, V any](fieldName string, m map[K]V, rules ...ValidationRule) []ValidationFunc {
+	return eachEntry(fieldName, m, rules, func(_ K, value V) any { return value })
+}
+
+// Applies the rules to every value of a map
+// Validating an object created with EachValueShortCircuit will return the first error
+func EachValueShortCircuit[K comparable, V any](fieldName string, m map[K]V, rules ...ValidationRule) ValidationFunc {
+	return ComposeShortCircuit(EachValue(fieldName, m, rules...))
+}
+
+func eachEntry[K comparable, V any](fieldName string, m map[K]V, rules []ValidationRule, pick func(K, V) any) []ValidationFunc {
+	path := ParsePath(fieldName)
+
+	funcs := make([]ValidationFunc, 0, len(m)*len(rules))
+	for _, key := range sortedKeys(m) {
+		entryPath := path.Append(KeySegment(fmt.Sprint(key)))
+		value := pick(key, m[key])
+		for _, rule := range rules {
+			funcs = append(funcs, withPath(entryPath, rule(entryPath.String(), value)))
+		}
+	}
+	return funcs
+}
+
+// Map iteration order is random, keys are sorted so errors are always reported in the same order
+func sortedKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b K) int {
+		va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+		switch va.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(va.Int(), vb.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(va.Uint(), vb.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(va.Float(), vb.Float())
+		case reflect.String:
+			return cmp.Compare(va.String(), vb.String())
+		default:
+			return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		}
+	})
+
+	return keys
+}
@@ -1,11 +1,24 @@
 package govalid
 
-import "github.com/Palma99/govalid/internal"
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Palma99/govalid/internal"
+)
+
+// ValidationError is exported so custom validations can be written outside this module
+type ValidationError = internal.ValidationError
 
 type ValidationFunc func() *internal.ValidationError
 
 type ValidationRule func(field string, value any) ValidationFunc
 
+// Creates a validation error for a field, use its With* methods to set code, params and value
+func NewValidationError(field, message string) *ValidationError {
+	return internal.NewValidationError(field, message)
+}
+
 // ComposeShortCircuit combines all validation functions into one and return only the first error if any
 //
 // In this example only the name error will be returned, surname validation will not be evaluated
@@ -16,6 +29,8 @@ type ValidationRule func(field string, value any) ValidationFunc
 //	)
 //
 //	res := govalid.Validate(composed)
+//
+// It accepts ValidationFunc, []ValidationFunc and Validatable values and panics if other type is passed
 func ComposeShortCircuit(validations ...any) ValidationFunc {
 	return func() *internal.ValidationError {
 		for _, v := range validations {
@@ -30,6 +45,10 @@ func ComposeShortCircuit(validations ...any) ValidationFunc {
 						return err
 					}
 				}
+			case Validatable:
+				if errs := validateValidatable(failFastMode, validator).Errors(); len(errs) > 0 {
+					return &errs[0]
+				}
 			default:
 				panic("ComposeShortCircuit: unsupported type")
 			}
@@ -41,6 +60,8 @@ func ComposeShortCircuit(validations ...any) ValidationFunc {
 
 // Compose all validation functions into one
 // Validating an object created with Compose will return all errors
+// It accepts ValidationFunc and []ValidationFunc and panics if other type is passed,
+// Validatable values can return several errors so they are passed to Validate instead
 func Compose(validations ...any) []ValidationFunc {
 	funcs := make([]ValidationFunc, 0, len(validations))
 	for _, v := range validations {
@@ -49,6 +70,8 @@ func Compose(validations ...any) []ValidationFunc {
 			funcs = append(funcs, validator)
 		case []ValidationFunc:
 			funcs = append(funcs, validator...)
+		default:
+			panic(fmt.Sprintf("Compose: unsupported type %T", v))
 		}
 	}
 	return funcs
@@ -70,3 +93,48 @@ func Group(fieldName string, value any, rules ...ValidationRule) []ValidationFun
 	}
 	return funcs
 }
+
+// Code of the errors returned when a rule receives a value of a type it does not support
+const CodeUnsupportedType = internal.CodeUnsupportedType
+
+// TypedRule is a ValidationRule for values of type T, so type mismatches are compile errors
+type TypedRule[T any] func(field string, value T) ValidationFunc
+
+// Utility function to create a group of typed validation rules for a field
+// Validating an object created with GroupOf will return all errors
+//
+//	govalid.GroupOf("name", person.Name,
+//		typed.NonEmpty(),
+//		typed.MinLength(3),
+//	)
+func GroupOf[T any](fieldName string, value T, rules ...TypedRule[T]) []ValidationFunc {
+	funcs := make([]ValidationFunc, 0, len(rules))
+	for _, rule := range rules {
+		funcs = append(funcs, rule(fieldName, value))
+	}
+	return funcs
+}
+
+// Utility function to create a group of typed validation rules for a field
+// Validating a group will return the first error
+func GroupOfShortCircuit[T any](fieldName string, value T, rules ...TypedRule[T]) ValidationFunc {
+	return ComposeShortCircuit(GroupOf(fieldName, value, rules...))
+}
+
+// Adapts a TypedRule into a ValidationRule for use with Group
+// Values that are not of type T fail with a CodeUnsupportedType error instead of panicking
+func AnyRule[T any](rule TypedRule[T]) ValidationRule {
+	return func(field string, value any) ValidationFunc {
+		v, ok := value.(T)
+		if !ok {
+			return unsupportedType[T](field, value)
+		}
+		return rule(field, v)
+	}
+}
+
+func unsupportedType[T any](field string, value any) ValidationFunc {
+	return func() *internal.ValidationError {
+		return internal.NewUnsupportedTypeError(field, value, reflect.TypeFor[T]().String())
+	}
+}
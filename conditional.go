@@ -0,0 +1,65 @@
+package govalid
+
+import "github.com/Palma99/govalid/internal"
+
+// Runs the validations only if predicate returns true, the predicate is evaluated
+// every time a validation runs
+// It accepts ValidationFunc and/or []ValidationFunc and panics if other type is passed
+//
+//	govalid.Validate(
+//		govalid.When(func() bool { return form.Country == "IT" },
+//			validators.NonEmpty("vat_number", form.VatNumber),
+//		),
+//	)
+func When(predicate func() bool, validations ...any) []ValidationFunc {
+	funcs := make([]ValidationFunc, 0, len(validations))
+	for _, v := range validations {
+		switch validator := v.(type) {
+		case ValidationFunc:
+			funcs = append(funcs, conditional(predicate, validator))
+		case []ValidationFunc:
+			for _, validation := range validator {
+				funcs = append(funcs, conditional(predicate, validation))
+			}
+		default:
+			panic("When: unsupported type")
+		}
+	}
+	return funcs
+}
+
+// Runs the validations only if predicate returns false
+func Unless(predicate func() bool, validations ...any) []ValidationFunc {
+	return When(not(predicate), validations...)
+}
+
+// Applies the rule only if predicate returns true, for use with Group
+//
+//	govalid.Group("vat_number", form.VatNumber,
+//		govalid.WhenRule(isItalian, validators.MinLengthRule(11)),
+//	)
+func WhenRule(predicate func() bool, rule ValidationRule) ValidationRule {
+	return func(field string, value any) ValidationFunc {
+		return conditional(predicate, rule(field, value))
+	}
+}
+
+// Applies the rule only if predicate returns false, for use with Group
+func UnlessRule(predicate func() bool, rule ValidationRule) ValidationRule {
+	return WhenRule(not(predicate), rule)
+}
+
+func conditional(predicate func() bool, validation ValidationFunc) ValidationFunc {
+	return func() *internal.ValidationError {
+		if !predicate() {
+			return nil
+		}
+		return validation()
+	}
+}
+
+func not(predicate func() bool) func() bool {
+	return func() bool {
+		return !predicate()
+	}
+}
@@ -0,0 +1,130 @@
+package govalid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Palma99/govalid/internal"
+)
+
+// ValidationFuncCtx is a ValidationFunc receiving a context,
+// i.e. for validations that query a database or a remote service
+type ValidationFuncCtx func(ctx context.Context) *internal.ValidationError
+
+// InterruptedError is returned when the context is done before all validations ran
+type InterruptedError struct {
+	// Positions of the arguments of ValidateCtx with validations that did not run, in order
+	// An argument like a Group is reported if any of its validations did not run
+	Skipped []int
+	cause   error
+}
+
+func (e *InterruptedError) Error() string {
+	return fmt.Sprintf("validation interrupted: %v, %d arguments not fully validated", e.cause, len(e.Skipped))
+}
+
+// Returns the context error, so errors.Is(err, context.Canceled) can be used
+func (e *InterruptedError) Unwrap() error {
+	return e.cause
+}
+
+// Adapts a ValidationFunc into a ValidationFuncCtx, the context is ignored
+func WithContext(validation ValidationFunc) ValidationFuncCtx {
+	return func(context.Context) *internal.ValidationError {
+		return validation()
+	}
+}
+
+// A flattened validation, a Validatable value can return several errors
+type validationCtx func(ctx context.Context) []ValidationError
+
+func singleError(err *internal.ValidationError) []ValidationError {
+	if err == nil {
+		return nil
+	}
+	return []ValidationError{*err}
+}
+
+// Flattens the validations into a list, also returning the argument position of each one
+func flattenCtx(name string, failFastMode bool, validations ...any) ([]validationCtx, []int) {
+	funcs := make([]validationCtx, 0, len(validations))
+	args := make([]int, 0, len(validations))
+	for i, v := range validations {
+		switch validator := v.(type) {
+		case ValidationFuncCtx:
+			funcs = append(funcs, func(ctx context.Context) []ValidationError {
+				return singleError(validator(ctx))
+			})
+		case []ValidationFuncCtx:
+			for _, validation := range validator {
+				funcs = append(funcs, func(ctx context.Context) []ValidationError {
+					return singleError(validation(ctx))
+				})
+			}
+		case ValidationFunc:
+			funcs = append(funcs, func(context.Context) []ValidationError {
+				return singleError(validator())
+			})
+		case []ValidationFunc:
+			for _, validation := range validator {
+				funcs = append(funcs, func(context.Context) []ValidationError {
+					return singleError(validation())
+				})
+			}
+		case Validatable:
+			funcs = append(funcs, func(context.Context) []ValidationError {
+				return validateValidatable(failFastMode, validator).Errors()
+			})
+		default:
+			panic(fmt.Sprintf("%s: unsupported type %T", name, v))
+		}
+		for len(args) < len(funcs) {
+			args = append(args, i)
+		}
+	}
+	return funcs, args
+}
+
+func applyValidationsCtx(ctx context.Context, failFastMode bool, validations []validationCtx, args []int) (ValidationResult, error) {
+	result := NewValidationResult()
+
+	for i, validation := range validations {
+		if err := ctx.Err(); err != nil {
+			var skipped []int
+			for _, arg := range args[i:] {
+				if len(skipped) == 0 || skipped[len(skipped)-1] != arg {
+					skipped = append(skipped, arg)
+				}
+			}
+			return result, &InterruptedError{Skipped: skipped, cause: err}
+		}
+
+		errs := validation(ctx)
+		for _, err := range errs {
+			result.addError(err)
+		}
+		if failFastMode && len(errs) > 0 {
+			return result, nil
+		}
+	}
+
+	return result, nil
+}
+
+// Runs all validations passing them ctx and returns errors
+// It accepts ValidationFuncCtx, ValidationFunc, slices of them and Validatable values,
+// and panics if other type is passed. Validatable values do not receive ctx
+//
+// If ctx is done before all validations ran, the errors collected so far are returned
+// together with an *InterruptedError reporting the validations that did not run
+func ValidateCtx(ctx context.Context, validations ...any) (ValidationResult, error) {
+	funcs, args := flattenCtx("ValidateCtx", validateAllMode, validations...)
+	return applyValidationsCtx(ctx, validateAllMode, funcs, args)
+}
+
+// Runs all validations passing them ctx and stops at the first error, if any
+// It returns an *InterruptedError if ctx is done before an error is found
+func ValidateShortCircuitCtx(ctx context.Context, validations ...any) (ValidationResult, error) {
+	funcs, args := flattenCtx("ValidateShortCircuitCtx", failFastMode, validations...)
+	return applyValidationsCtx(ctx, failFastMode, funcs, args)
+}
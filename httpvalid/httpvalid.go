@@ -0,0 +1,321 @@
+// Package httpvalid decodes and validates JSON request bodies, writing problem
+// details (RFC 9457) when they are not valid
+//
+//	http.Handle("POST /users", httpvalid.Handler(httpvalid.Options{},
+//		func(w http.ResponseWriter, r *http.Request, user CreateUser) {
+//			// user is decoded and valid
+//		},
+//	))
+package httpvalid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/schema"
+)
+
+const (
+	DefaultMaxBodyBytes = 1 << 20
+	DefaultStatus       = http.StatusUnprocessableEntity
+)
+
+// CodeType is the code of body members with a wrong JSON type, i.e. a string for an int field
+const CodeType = "type"
+
+// Options configures decoding and error responses, the zero value uses the defaults
+type Options struct {
+	// Maximum size of the request body, DefaultMaxBodyBytes if zero
+	MaxBodyBytes int64
+	// Response status of validation errors, DefaultStatus if zero
+	Status int
+	// Rejects bodies with members that are not fields of the target type
+	DisallowUnknownFields bool
+	// Returns the locale used to translate the messages, i.e. AcceptLanguage
+	// Messages are not translated if nil
+	Locale func(r *http.Request) string
+	// Writes the error response, WriteError if nil
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err *Error)
+}
+
+// Error is a request body that could not be decoded or is not valid
+type Error struct {
+	// Response status, i.e. 400 for malformed JSON or 422 for validation errors
+	Status int
+	// Describes decoding errors, empty for validation errors
+	Detail string
+	// Validation errors, and members with a wrong JSON type
+	Result govalid.ValidationResult
+	// Decoding error, if any
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Detail != "" {
+		return e.Detail
+	}
+	if count := e.Result.ErrorCount(); count != 1 {
+		return fmt.Sprintf("%d validation errors", count)
+	}
+	return "1 validation error"
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// HandlerFunc handles a request with its decoded and validated body
+type HandlerFunc[T any] func(w http.ResponseWriter, r *http.Request, value T)
+
+var (
+	validatorsMu sync.RWMutex
+	validators   = map[reflect.Type]func(any) govalid.ValidationResult{}
+)
+
+// Registers the validator of a request type that does not implement govalid.Validatable
+// It panics if a validator is already registered for T
+func Register[T any](validate func(value T) govalid.ValidationResult) {
+	validatorsMu.Lock()
+	defer validatorsMu.Unlock()
+
+	t := reflect.TypeFor[T]()
+	if _, ok := validators[t]; ok {
+		panic(fmt.Sprintf("httpvalid: validator already registered for %s", t))
+	}
+	validators[t] = func(value any) govalid.ValidationResult {
+		return validate(value.(T))
+	}
+}
+
+// Registers a schema file as validator of a request type
+// It panics if a validator is already registered for T
+func RegisterSchema[T any](s *schema.Schema) {
+	Register(func(value T) govalid.ValidationResult {
+		return s.Validate(value)
+	})
+}
+
+// Returns the validation result of a request body, the validator is, in order:
+// govalid.Validatable implemented by T or *T, including its nested Validatable values,
+// the validator registered for T, the `validate` tags if T is a struct or a pointer to a struct
+func validate[T any](value T) govalid.ValidationResult {
+	if v, ok := any(value).(govalid.Validatable); ok {
+		return govalid.Validate(v)
+	}
+	if v, ok := any(&value).(govalid.Validatable); ok {
+		return govalid.Validate(v)
+	}
+
+	validatorsMu.RLock()
+	validator, ok := validators[reflect.TypeFor[T]()]
+	validatorsMu.RUnlock()
+	if ok {
+		return validator(value)
+	}
+
+	t := reflect.TypeFor[T]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct {
+		return govalid.ValidateStruct(value)
+	}
+
+	return govalid.NewValidationResult()
+}
+
+// Decodes and validates the JSON body of a request
+// It returns an *Error if the body can not be decoded or is not valid
+func Decode[T any](opts Options, r *http.Request) (T, error) {
+	var value T
+
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+			return value, &Error{Status: http.StatusUnsupportedMediaType, Detail: "request body must be JSON"}
+		}
+	}
+
+	maxBytes := opts.MaxBodyBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBodyBytes
+	}
+
+	// One more byte is read to detect bodies that are too large
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBytes+1))
+	if err != nil {
+		return value, &Error{Status: http.StatusBadRequest, Detail: "request body can not be read", Err: err}
+	}
+	if int64(len(body)) > maxBytes {
+		return value, &Error{Status: http.StatusRequestEntityTooLarge, Detail: fmt.Sprintf("request body is larger than %d bytes", maxBytes)}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if opts.DisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	if err := decoder.Decode(&value); err != nil {
+		return value, decodeError(err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return value, &Error{Status: http.StatusBadRequest, Detail: "request body must contain a single JSON value"}
+	}
+
+	if rv := reflect.ValueOf(&value).Elem(); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return value, &Error{Status: http.StatusBadRequest, Detail: "request body must not be null"}
+	}
+
+	if result := validate(value); !result.IsValid() {
+		status := opts.Status
+		if status == 0 {
+			status = DefaultStatus
+		}
+		return value, &Error{Status: status, Result: result}
+	}
+
+	return value, nil
+}
+
+func decodeError(err error) *Error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return &Error{Status: http.StatusBadRequest, Detail: "request body is empty", Err: err}
+	case errors.As(err, &typeErr):
+		// Members with a wrong type are reported like validation errors, on their path
+		expected := jsonType(typeErr.Type)
+		result := govalid.NewValidationResult(
+			*govalid.NewValidationError(typeErr.Field, "must be of type "+expected).
+				WithCode(CodeType).WithParam("type", expected),
+		)
+		return &Error{Status: http.StatusBadRequest, Detail: "request body has members of the wrong type", Result: result, Err: err}
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return &Error{Status: http.StatusBadRequest, Detail: "request body is not valid JSON", Err: err}
+	default:
+		// i.e. unknown fields, the message contains the field name
+		return &Error{Status: http.StatusBadRequest, Detail: "invalid request body: " + strings.TrimPrefix(err.Error(), "json: "), Err: err}
+	}
+}
+
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// Writes the problem details of a decoding or validation error
+func WriteError(opts Options, w http.ResponseWriter, r *http.Request, err *Error) {
+	result := err.Result
+	if opts.Locale != nil {
+		result = result.Localize(opts.Locale(r))
+	}
+
+	problem := govalid.NewProblemDetails(err.Status, result)
+	if err.Detail != "" {
+		problem.Detail = err.Detail
+	}
+	problem.Write(w)
+}
+
+func handleError(opts Options, w http.ResponseWriter, r *http.Request, err error) {
+	var decodeErr *Error
+	if !errors.As(err, &decodeErr) {
+		decodeErr = &Error{Status: http.StatusBadRequest, Detail: err.Error(), Err: err}
+	}
+
+	if opts.ErrorHandler != nil {
+		opts.ErrorHandler(w, r, decodeErr)
+		return
+	}
+	WriteError(opts, w, r, decodeErr)
+}
+
+// Returns a handler that decodes and validates the request body before calling handle,
+// on failure the error response is written and handle is not called
+func Handler[T any](opts Options, handle HandlerFunc[T]) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value, err := Decode[T](opts, r)
+		if err != nil {
+			handleError(opts, w, r, err)
+			return
+		}
+		handle(w, r, value)
+	})
+}
+
+type contextKey[T any] struct{}
+
+// Returns a middleware that decodes and validates the request body, the value
+// is available to the next handler with FromContext
+func Middleware[T any](opts Options) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return Handler(opts, func(w http.ResponseWriter, r *http.Request, value T) {
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), value)))
+		})
+	}
+}
+
+// Returns a copy of ctx carrying a validated value
+func NewContext[T any](ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, contextKey[T]{}, value)
+}
+
+// Returns the value validated by Middleware, and whether it is present
+func FromContext[T any](ctx context.Context) (T, bool) {
+	value, ok := ctx.Value(contextKey[T]{}).(T)
+	return value, ok
+}
+
+// Returns the preferred language of the Accept-Language header, i.e. "it-CH"
+// for "it-CH, it;q=0.9, en;q=0.8", or an empty string
+func AcceptLanguage(r *http.Request) string {
+	best, bestQuality := "", 0.0
+
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if _, err := fmt.Sscanf(q, "%g", &quality); err != nil {
+				continue
+			}
+		}
+		if quality > bestQuality {
+			best, bestQuality = tag, quality
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,120 @@
+package govalid
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Locale used when none of the requested locales has a message for an error
+const DefaultLocale = "en"
+
+// MessageCatalog provides the message templates of a locale, keyed by error code
+// Templates can reference the error params, the field and the value, i.e.
+// "must be at least {min} characters" or "{field} is required"
+type MessageCatalog interface {
+	Message(code string) (string, bool)
+}
+
+// MapCatalog is a MessageCatalog backed by a map
+type MapCatalog map[string]string
+
+func (c MapCatalog) Message(code string) (string, bool) {
+	message, ok := c[code]
+	return message, ok
+}
+
+var (
+	catalogsMu sync.RWMutex
+	catalogs   = map[string][]MessageCatalog{}
+)
+
+// Registers a catalog for a locale, i.e. "it" or "de-AT"
+// Catalogs registered later for the same locale take precedence,
+// so built-in messages can be overridden or extended with custom codes
+func RegisterCatalog(locale string, catalog MessageCatalog) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+
+	locale = normalizeLocale(locale)
+	catalogs[locale] = append(catalogs[locale], catalog)
+}
+
+// Returns the locales to look up, in order: each requested locale followed
+// by its parents ("de-AT" then "de"), and DefaultLocale last
+func fallbackChain(locales ...string) []string {
+	var chain []string
+	add := func(locale string) {
+		for _, l := range chain {
+			if l == locale {
+				return
+			}
+		}
+		chain = append(chain, locale)
+	}
+
+	for _, locale := range append(locales, DefaultLocale) {
+		locale = normalizeLocale(locale)
+		for locale != "" {
+			add(locale)
+			i := strings.LastIndex(locale, "-")
+			if i < 0 {
+				break
+			}
+			locale = locale[:i]
+		}
+	}
+
+	return chain
+}
+
+func lookupMessage(chain []string, code string) (string, bool) {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+
+	for _, locale := range chain {
+		localeCatalogs := catalogs[locale]
+		for i := len(localeCatalogs) - 1; i >= 0; i-- {
+			if message, ok := localeCatalogs[i].Message(code); ok {
+				return message, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func renderMessage(template string, err ValidationError) string {
+	replacements := []string{"{field}", err.Field(), "{value}", fmt.Sprint(err.Value())}
+	for name, value := range err.Params() {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+
+	return strings.NewReplacer(replacements...).Replace(template)
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// Returns a copy of the result with messages translated to locale
+// If locale has no message for an error code, fallbacks are tried in order,
+// then DefaultLocale. Custom messages and errors without a code are not changed
+//
+//	res.Localize("it-CH")       // it-ch, it, en
+//	res.Localize("fr", "de")    // fr, de, en
+func (r ValidationResult) Localize(locale string, fallbacks ...string) ValidationResult {
+	chain := fallbackChain(append([]string{locale}, fallbacks...)...)
+
+	result := NewValidationResult()
+	for _, err := range r.errors {
+		if err.Code() != "" && !err.HasCustomMessage() {
+			if template, ok := lookupMessage(chain, err.Code()); ok {
+				err.WithMessage(renderMessage(template, err))
+			}
+		}
+		result.addError(err)
+	}
+
+	return result
+}
@@ -0,0 +1,561 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	// Registers the built-in rules, SplitTag needs them to split parameters containing commas
+	_ "github.com/Palma99/govalid/validators"
+)
+
+const (
+	structTagName = "validate"
+	header        = "// Code generated by govalid-gen. DO NOT EDIT."
+	// Unexported method returning the validations of a struct, so parents can nest them
+	validationsMethod = "govalidValidations"
+)
+
+var integerTypes = []string{
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "byte", "rune",
+}
+
+var numberTypes = append([]string{"float32", "float64"}, integerTypes...)
+
+type field struct {
+	name string
+	expr string
+	typ  ast.Expr
+	tag  string
+	pos  token.Position
+}
+
+type structType struct {
+	name   string
+	fields []field
+}
+
+type generator struct {
+	// Structs declared in the package, in file order
+	structs []*structType
+	byName  map[string]*structType
+	// Underlying types of the non-struct types declared in the package
+	declared map[string]string
+	// Structs with validations, directly or through their fields
+	walked  map[string]bool
+	imports map[string]bool
+}
+
+// Generate parses the non-test Go files of dir and returns the formatted source
+// of a Validate method for every struct having at least one `validate` tag, or a
+// field of such a struct type. Like ValidateStruct, nested structs, pointers to
+// structs and slices or arrays of them are walked, as long as their type is declared
+// in the same package. Types of other packages, i.e. time.Time, are not walked.
+// If typeNames is not empty only the listed types, and the types they nest, are generated
+func Generate(dir string, typeNames []string) ([]byte, string, error) {
+	fset := token.NewFileSet()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, "", err
+	}
+	slices.Sort(paths)
+
+	g := &generator{
+		byName:   map[string]*structType{},
+		declared: map[string]string{},
+		walked:   map[string]bool{},
+		imports:  map[string]bool{},
+	}
+	var pkgName string
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, "", err
+		}
+		if isGenerated(file) {
+			continue
+		}
+
+		if pkgName == "" {
+			pkgName = file.Name.Name
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if ident, ok := typeSpec.Type.(*ast.Ident); ok {
+					g.declared[typeSpec.Name.Name] = ident.Name
+				}
+
+				st, ok := typeSpec.Type.(*ast.StructType)
+				if !ok || typeSpec.TypeParams != nil {
+					continue
+				}
+
+				s := parseStruct(fset, typeSpec.Name.Name, st)
+				g.structs = append(g.structs, s)
+				g.byName[s.name] = s
+			}
+		}
+	}
+
+	if pkgName == "" {
+		return nil, "", fmt.Errorf("no Go files found in %s", dir)
+	}
+
+	g.markWalked()
+
+	selected := map[string]bool{}
+	for _, name := range typeNames {
+		if !g.walked[name] {
+			return nil, "", fmt.Errorf("type %s not found or has no %s tags", name, structTagName)
+		}
+		g.selectWithNested(name, selected)
+	}
+
+	var structs []*structType
+	for _, s := range g.structs {
+		if g.walked[s.name] && (len(typeNames) == 0 || selected[s.name]) {
+			structs = append(structs, s)
+		}
+	}
+
+	src, err := g.render(pkgName, structs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return src, pkgName, nil
+}
+
+func isGenerated(file *ast.File) bool {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if comment.Text == header {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Collects the exported fields not tagged with "-", like ValidateStruct, untagged fields
+// are kept as they can nest other structs
+func parseStruct(fset *token.FileSet, name string, st *ast.StructType) *structType {
+	s := &structType{name: name}
+
+	for _, f := range st.Fields.List {
+		var tags reflect.StructTag
+		if f.Tag != nil {
+			rawTag, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				continue
+			}
+			tags = reflect.StructTag(rawTag)
+		}
+
+		tag := tags.Get(structTagName)
+		if tag == "-" {
+			continue
+		}
+
+		names := f.Names
+		// Embedded fields are named after their type
+		if len(names) == 0 {
+			ident := embeddedName(f.Type)
+			if ident == nil {
+				continue
+			}
+			names = []*ast.Ident{ident}
+		}
+
+		for _, ident := range names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			fieldName := ident.Name
+			if jsonName, _, _ := strings.Cut(tags.Get("json"), ","); jsonName != "" && jsonName != "-" {
+				fieldName = jsonName
+			}
+
+			s.fields = append(s.fields, field{
+				name: fieldName,
+				expr: "x." + ident.Name,
+				typ:  f.Type,
+				tag:  tag,
+				pos:  fset.Position(f.Pos()),
+			})
+		}
+	}
+
+	return s
+}
+
+func embeddedName(expr ast.Expr) *ast.Ident {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel
+	}
+	return nil
+}
+
+// Marks the structs with tags, then the structs nesting them, until nothing changes
+// Structs with tagged anonymous struct fields are marked too, so rendering reports them
+func (g *generator) markWalked() {
+	for _, s := range g.structs {
+		for _, f := range s.fields {
+			if f.tag != "" || anonymousWithTags(f.typ) {
+				g.walked[s.name] = true
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, s := range g.structs {
+			if g.walked[s.name] {
+				continue
+			}
+			for _, f := range s.fields {
+				if name, ok := g.nestedStruct(f.typ); ok && g.walked[name] {
+					g.walked[s.name], changed = true, true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (g *generator) selectWithNested(name string, selected map[string]bool) {
+	if selected[name] {
+		return
+	}
+	selected[name] = true
+
+	for _, f := range g.byName[name].fields {
+		if nested, ok := g.nestedStruct(f.typ); ok && g.walked[nested] {
+			g.selectWithNested(nested, selected)
+		}
+	}
+}
+
+// Returns the package struct reached through pointers, slices and arrays of typ, if any
+func (g *generator) nestedStruct(typ ast.Expr) (string, bool) {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		_, ok := g.byName[t.Name]
+		return t.Name, ok
+	case *ast.StarExpr:
+		return g.nestedStruct(t.X)
+	case *ast.ArrayType:
+		return g.nestedStruct(t.Elt)
+	}
+	return "", false
+}
+
+func typeString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func (g *generator) render(pkgName string, structs []*structType) ([]byte, error) {
+	var body bytes.Buffer
+
+	for _, s := range structs {
+		fmt.Fprintf(&body, "\nfunc (x *%s) Validate() govalid.ValidationResult {\n", s.name)
+		fmt.Fprintf(&body, "\treturn govalid.Validate(x.%s())\n}\n", validationsMethod)
+
+		// Nested structs are validated by the method, so govalid.Validate does not discover them again
+		fmt.Fprintf(&body, "\nfunc (x *%s) ValidatesNested() {}\n", s.name)
+
+		fmt.Fprintf(&body, "\nfunc (x *%s) %s() []govalid.ValidationFunc {\n", s.name, validationsMethod)
+		body.WriteString("\tvar funcs []govalid.ValidationFunc\n")
+
+		// Consecutive calls are appended at once, statements like nil checks flush them
+		var calls []string
+		for _, f := range s.fields {
+			var block bytes.Buffer
+			fieldCalls, err := g.renderField(&block, f)
+			if err != nil {
+				return nil, fmt.Errorf("%s: field %s: %w", f.pos, f.name, err)
+			}
+
+			calls = append(calls, fieldCalls...)
+			if block.Len() > 0 {
+				writeAppend(&body, calls)
+				body.Write(block.Bytes())
+				calls = nil
+			}
+		}
+		writeAppend(&body, calls)
+
+		body.WriteString("\treturn funcs\n}\n")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s\n\npackage %s\n\n", header, pkgName)
+	buf.WriteString("import (\n")
+	if g.imports["strconv"] {
+		buf.WriteString("\t\"strconv\"\n\n")
+	}
+	buf.WriteString("\t\"github.com/Palma99/govalid\"\n")
+	if g.imports["validators"] {
+		buf.WriteString("\t\"github.com/Palma99/govalid/validators\"\n")
+	}
+	buf.WriteString(")\n")
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+// Renders the rules of a field followed by the validations of the structs it nests,
+// as calls to append, or statements written to buf if they follow them
+// A nil pointer has no value to check, only the required rule is applied, like ValidateStruct does
+func (g *generator) renderField(buf *bytes.Buffer, f field) ([]string, error) {
+	valueType, pointer := f.typ, false
+	if star, ok := f.typ.(*ast.StarExpr); ok {
+		valueType, pointer = star.X, true
+	}
+
+	if _, ok := valueType.(*ast.StarExpr); ok && pointer {
+		return nil, fmt.Errorf("pointers to pointers are not supported by govalid-gen")
+	}
+
+	var rules, nilRules []string
+	// Tags are split and parsed like ValidateStruct does
+	for _, entry := range govalid.SplitTag(f.tag) {
+		value := f.expr
+		if pointer {
+			value = "*" + f.expr
+		}
+		call, err := g.renderRule(f.name, value, valueType, entry)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, call)
+
+		if ruleName, _ := govalid.ParseRuleSpec(entry); ruleName == "required" {
+			nilRules = append(nilRules, fmt.Sprintf("validators.NonEmpty(%s, nil)", strconv.Quote(f.name)))
+		}
+	}
+	if len(rules) > 0 {
+		g.imports["validators"] = true
+	}
+
+	var nested bytes.Buffer
+	if err := g.renderNested(&nested, f.expr, strconv.Quote(f.name), valueType, 0); err != nil {
+		return nil, err
+	}
+	if _, ok := valueType.(*ast.Ident); pointer && !ok && nested.Len() > 0 {
+		return nil, fmt.Errorf("type %s is not supported by govalid-gen", typeString(f.typ))
+	}
+
+	if !pointer {
+		buf.Write(nested.Bytes())
+		return rules, nil
+	}
+
+	if len(rules) == 0 && nested.Len() == 0 {
+		return nil, nil
+	}
+	if len(nilRules) > 0 {
+		fmt.Fprintf(buf, "\tif %s == nil {\n", f.expr)
+		writeAppend(buf, nilRules)
+		buf.WriteString("\t} else {\n")
+	} else {
+		fmt.Fprintf(buf, "\tif %s != nil {\n", f.expr)
+	}
+	writeAppend(buf, rules)
+	buf.Write(nested.Bytes())
+	buf.WriteString("\t}\n")
+	return nil, nil
+}
+
+func writeAppend(buf *bytes.Buffer, calls []string) {
+	if len(calls) == 0 {
+		return
+	}
+	buf.WriteString("\tfuncs = append(funcs,\n")
+	for _, call := range calls {
+		fmt.Fprintf(buf, "\t\t%s,\n", call)
+	}
+	buf.WriteString("\t)\n")
+}
+
+// Nests the validations of the package structs reached from expr, prefixing their paths
+// with prefix, a Go expression of type string
+func (g *generator) renderNested(buf *bytes.Buffer, expr, prefix string, typ ast.Expr, depth int) error {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		if g.walked[t.Name] {
+			fmt.Fprintf(buf, "\tfuncs = append(funcs, govalid.Nest(%s, %s.%s())...)\n", prefix, expr, validationsMethod)
+		}
+	case *ast.StarExpr:
+		var nested bytes.Buffer
+		if err := g.renderNested(&nested, expr, prefix, t.X, depth); err != nil {
+			return err
+		}
+		if nested.Len() > 0 {
+			if _, ok := t.X.(*ast.Ident); !ok {
+				return fmt.Errorf("type %s is not supported by govalid-gen", typeString(typ))
+			}
+			fmt.Fprintf(buf, "\tif %s != nil {\n", expr)
+			buf.Write(nested.Bytes())
+			buf.WriteString("\t}\n")
+		}
+	case *ast.ArrayType:
+		index := "i"
+		if depth > 0 {
+			index += strconv.Itoa(depth)
+		}
+
+		var nested bytes.Buffer
+		item := fmt.Sprintf("%s[%s]", expr, index)
+		// prefix always ends with a string literal, the index is inserted in it
+		itemPrefix := fmt.Sprintf(`%s["+strconv.Itoa(%s)+"]"`, strings.TrimSuffix(prefix, `"`), index)
+		if err := g.renderNested(&nested, item, itemPrefix, t.Elt, depth+1); err != nil {
+			return err
+		}
+		if nested.Len() > 0 {
+			g.imports["strconv"] = true
+			fmt.Fprintf(buf, "\tfor %s := range %s {\n", index, expr)
+			buf.Write(nested.Bytes())
+			buf.WriteString("\t}\n")
+		}
+	case *ast.StructType:
+		if hasTags(t) {
+			return fmt.Errorf("anonymous struct types are not supported by govalid-gen, declare a named type")
+		}
+	}
+	return nil
+}
+
+func anonymousWithTags(typ ast.Expr) bool {
+	switch t := typ.(type) {
+	case *ast.StarExpr:
+		return anonymousWithTags(t.X)
+	case *ast.ArrayType:
+		return anonymousWithTags(t.Elt)
+	case *ast.StructType:
+		return hasTags(t)
+	}
+	return false
+}
+
+func hasTags(st *ast.StructType) bool {
+	for _, f := range st.Fields.List {
+		if f.Tag != nil && strings.Contains(f.Tag.Value, structTagName+":") {
+			return true
+		}
+		if nested, ok := f.Type.(*ast.StructType); ok && hasTags(nested) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) renderRule(name, value string, typ ast.Expr, entry string) (string, error) {
+	ruleName, param := govalid.ParseRuleSpec(entry)
+	fieldName := strconv.Quote(name)
+	typeName := typeString(typ)
+
+	switch ruleName {
+	case "required":
+		return fmt.Sprintf("validators.NonEmpty(%s, %s)", fieldName, value), nil
+	case "min_len", "max_len":
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s parameter %q", ruleName, param)
+		}
+		fn := "MinLength"
+		if ruleName == "max_len" {
+			fn = "MaxLength"
+		}
+		return fmt.Sprintf("validators.%s(%s, %s, %d)", fn, fieldName, value, n), nil
+	case "min", "max":
+		if !g.isNumber(typeName) {
+			return "", fmt.Errorf("%s requires a numeric field, got %s", ruleName, typeName)
+		}
+		if _, err := strconv.ParseFloat(param, 64); err != nil {
+			return "", fmt.Errorf("invalid %s parameter %q", ruleName, param)
+		}
+		if g.isInteger(typeName) {
+			if _, err := strconv.ParseInt(param, 10, 64); err != nil {
+				return "", fmt.Errorf("%s parameter %q is not an integer", ruleName, param)
+			}
+		}
+		// The bound is converted to the field type, so it must be representable
+		if g.isUnsigned(typeName) && strings.HasPrefix(param, "-") {
+			return "", fmt.Errorf("%s parameter %q is negative for the unsigned type %s", ruleName, param, typeName)
+		}
+		fn := "Min"
+		if ruleName == "max" {
+			fn = "Max"
+		}
+		return fmt.Sprintf("validators.%s[%s](%s, %s, %s)", fn, typeName, fieldName, value, param), nil
+	case "matches", "email":
+		if g.underlying(typeName) != "string" {
+			return "", fmt.Errorf("%s requires a string field, got %s", ruleName, typeName)
+		}
+		if typeName != "string" {
+			value = fmt.Sprintf("string(%s)", value)
+		}
+		if ruleName == "email" {
+			return fmt.Sprintf("validators.IsEmail(%s, %s)", fieldName, value), nil
+		}
+		return fmt.Sprintf("validators.MatchesRegex(%s, %s, %s)", fieldName, value, strconv.Quote(param)), nil
+	default:
+		return "", fmt.Errorf("rule %q is not supported by govalid-gen", ruleName)
+	}
+}
+
+func (g *generator) underlying(typeName string) string {
+	for i := 0; i < len(g.declared); i++ {
+		u, ok := g.declared[typeName]
+		if !ok {
+			break
+		}
+		typeName = u
+	}
+	return typeName
+}
+
+func (g *generator) isNumber(typeName string) bool {
+	return slices.Contains(numberTypes, g.underlying(typeName))
+}
+
+func (g *generator) isInteger(typeName string) bool {
+	return slices.Contains(integerTypes, g.underlying(typeName))
+}
+
+func (g *generator) isUnsigned(typeName string) bool {
+	u := g.underlying(typeName)
+	return strings.HasPrefix(u, "uint") || u == "byte"
+}
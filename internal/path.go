@@ -0,0 +1,201 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+)
+
+type SegmentKind int
+
+const (
+	// A struct field or object member, i.e. "address"
+	FieldKind SegmentKind = iota
+	// A slice or array index, i.e. [2]
+	IndexKind
+	// A map key, i.e. [it]
+	KeyKind
+)
+
+// PathSegment is a single step of a Path
+type PathSegment struct {
+	kind  SegmentKind
+	name  string
+	index int
+}
+
+func FieldSegment(name string) PathSegment {
+	return PathSegment{kind: FieldKind, name: name}
+}
+
+func IndexSegment(index int) PathSegment {
+	return PathSegment{kind: IndexKind, index: index}
+}
+
+func KeySegment(key string) PathSegment {
+	return PathSegment{kind: KeyKind, name: key}
+}
+
+func (s PathSegment) Kind() SegmentKind {
+	return s.kind
+}
+
+// Returns the field name, the index or the key of the segment as a string
+func (s PathSegment) String() string {
+	if s.kind == IndexKind {
+		return strconv.Itoa(s.index)
+	}
+	return s.name
+}
+
+// Returns the index of an IndexKind segment
+func (s PathSegment) Index() int {
+	return s.index
+}
+
+// Path locates a value inside a nested structure, i.e. items[2].sku
+type Path []PathSegment
+
+func NewPath(segments ...PathSegment) Path {
+	return append(Path(nil), segments...)
+}
+
+// Parses a path in bracket or dotted notation, i.e. "items[2].sku" or "labels[env]"
+// Bracket contents made of digits are indexes, other contents are map keys
+// A backslash escapes the next character, so names and keys can contain dots and
+// brackets, i.e. `labels[a.b\]]` is the key "a.b]", see Path.Bracket
+func ParsePath(s string) Path {
+	if s == "" {
+		return nil
+	}
+
+	var (
+		path Path
+		name strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+			}
+			name.WriteByte(s[i])
+		case '.':
+			path = append(path, FieldSegment(name.String()))
+			name.Reset()
+		case '[':
+			if name.Len() > 0 {
+				path = append(path, FieldSegment(name.String()))
+				name.Reset()
+			}
+
+			var segment PathSegment
+			segment, i = parseBracket(s, i+1)
+			path = append(path, segment)
+			// A dot after a bracket only separates the next field
+			if i+1 < len(s) && s[i+1] == '.' {
+				i++
+			}
+		default:
+			name.WriteByte(c)
+		}
+	}
+	if name.Len() > 0 {
+		path = append(path, FieldSegment(name.String()))
+	}
+
+	return path
+}
+
+// Parses the content of a bracket starting at start, it returns the segment
+// and the position of the closing bracket
+func parseBracket(s string, start int) (PathSegment, int) {
+	var (
+		content strings.Builder
+		escaped bool
+	)
+
+	i := start
+	for ; i < len(s) && s[i] != ']'; i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			escaped = true
+		}
+		content.WriteByte(s[i])
+	}
+
+	if !escaped && isIndex(content.String()) {
+		index, _ := strconv.Atoi(content.String())
+		return IndexSegment(index), i
+	}
+	return KeySegment(content.String()), i
+}
+
+// Returns true if s is read as an index inside brackets
+func isIndex(s string) bool {
+	index, err := strconv.Atoi(s)
+	return err == nil && index >= 0
+}
+
+// Returns a new path with the segments appended
+func (p Path) Append(segments ...PathSegment) Path {
+	path := make(Path, 0, len(p)+len(segments))
+	return append(append(path, p...), segments...)
+}
+
+// Returns a new path with the prefix prepended
+func (p Path) Prepend(prefix Path) Path {
+	return prefix.Append(p...)
+}
+
+// Renders the path in bracket notation, i.e. items[2].sku
+func (p Path) String() string {
+	return p.Bracket()
+}
+
+var (
+	fieldEscaper = strings.NewReplacer(`\`, `\\`, ".", `\.`, "[", `\[`)
+	keyEscaper   = strings.NewReplacer(`\`, `\\`, "]", `\]`)
+)
+
+// Renders the path in bracket notation, i.e. items[2].sku or labels[env]
+// Dots and brackets in names and keys are escaped with a backslash, i.e. labels[a.b\]],
+// as are keys made of digits, i.e. labels[\2], so ParsePath returns the same path
+func (p Path) Bracket() string {
+	var b strings.Builder
+	for i, s := range p {
+		switch {
+		case s.kind == IndexKind:
+			b.WriteString("[" + s.String() + "]")
+		case s.kind == KeyKind && isIndex(s.name):
+			// Escaped contents are keys, so ParsePath does not read an index
+			b.WriteString(`[\` + s.name + "]")
+		case s.kind == KeyKind:
+			b.WriteString("[" + keyEscaper.Replace(s.name) + "]")
+		case i > 0:
+			b.WriteString("." + fieldEscaper.Replace(s.name))
+		default:
+			b.WriteString(fieldEscaper.Replace(s.name))
+		}
+	}
+	return b.String()
+}
+
+// Renders the path in dotted notation, i.e. items.2.sku
+func (p Path) Dotted() string {
+	parts := make([]string, 0, len(p))
+	for _, s := range p {
+		parts = append(parts, s.String())
+	}
+	return strings.Join(parts, ".")
+}
+
+// Renders the path as an RFC 6901 JSON Pointer, i.e. /items/2/sku
+func (p Path) JSONPointer() string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+
+	var b strings.Builder
+	for _, s := range p {
+		b.WriteString("/" + escaper.Replace(s.String()))
+	}
+	return b.String()
+}
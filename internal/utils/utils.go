@@ -1,6 +1,11 @@
 package utils
 
-import "errors"
+import (
+	"cmp"
+	"errors"
+	"reflect"
+	"time"
+)
 
 func GetLength(value any) (int, error) {
 	switch v := value.(type) {
@@ -10,15 +15,88 @@ func GetLength(value any) (int, error) {
 		return len(v), nil
 	case map[string]any:
 		return len(v), nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), nil
 	default:
 		return 0, errors.ErrUnsupported
 	}
 }
 
-func GetOptionalStringOrDefault(d string, arg ...string) string {
-	if len(arg) > 0 {
-		return arg[0]
+// Converts any numeric value, including named numeric types, to float64
+func ToFloat64(value any) (float64, bool) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// Converts named string types to string, other values are returned unchanged
+func NormalizeString(value any) any {
+	if _, ok := value.(string); ok {
+		return value
+	}
+
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.String {
+		return rv.String()
+	}
+
+	return value
+}
+
+// Compares two values of the same kind: numbers, strings or time.Time
+// It returns -1, 0 or +1, or an error if the values can not be compared
+func Compare(a, b any) (int, error) {
+	if ta, ok := a.(time.Time); ok {
+		if tb, ok := b.(time.Time); ok {
+			return ta.Compare(tb), nil
+		}
+		return 0, errors.ErrUnsupported
 	}
 
-	return d
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case isSigned(va) && isSigned(vb):
+		return cmp.Compare(va.Int(), vb.Int()), nil
+	case isUnsigned(va) && isUnsigned(vb):
+		return cmp.Compare(va.Uint(), vb.Uint()), nil
+	case va.Kind() == reflect.String && vb.Kind() == reflect.String:
+		return cmp.Compare(va.String(), vb.String()), nil
+	}
+
+	fa, okA := ToFloat64(a)
+	fb, okB := ToFloat64(b)
+	if okA && okB {
+		return cmp.Compare(fa, fb), nil
+	}
+
+	return 0, errors.ErrUnsupported
+}
+
+func isSigned(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUnsigned(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
 }
@@ -1,36 +1,225 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
 )
 
 type ValidationError struct {
-	field   string
+	path Path
+	// The field name given verbatim when it is not written as a path, see fieldPath
+	name    string
 	message string
+	code    string
+	params  map[string]any
+	value   any
+	// Set when the message was provided by the caller instead of the validator
+	customMessage bool
 }
 
 func (e ValidationError) Error() error {
 	return fmt.Errorf("%s: %s", e.Field(), e.Message())
 }
 
+// The field is parsed as a path, so nested fields can be named like "items[2].sku"
+// Names that are not written as paths, i.e. `C:\dir` or "a.", are kept verbatim
 func NewValidationError(field, message string) *ValidationError {
+	path, name := fieldPath(field)
 	return &ValidationError{
-		field:   field,
+		path:    path,
+		name:    name,
 		message: message,
 	}
 }
 
 func NewValidationErrorf(field, format string, args ...any) *ValidationError {
-	return &ValidationError{
-		field:   field,
-		message: fmt.Sprintf(format, args...),
+	return NewValidationError(field, fmt.Sprintf(format, args...))
+}
+
+// Parses field as a path if it is rendered back the same, otherwise it is a single
+// field and its name is returned to be kept verbatim
+func fieldPath(field string) (Path, string) {
+	if path := ParsePath(field); path.Bracket() == field {
+		return path, ""
+	}
+	return Path{FieldSegment(field)}, field
+}
+
+// Returns validation changing a copy of its error with update, as a validation
+// can return a shared error
+func MapError(validation func() *ValidationError, update func(e *ValidationError)) func() *ValidationError {
+	return func() *ValidationError {
+		err := validation()
+		if err == nil {
+			return nil
+		}
+
+		e := *err
+		update(&e)
+		return &e
 	}
 }
 
+// Code of the errors returned for values of a type a validation does not support
+const CodeUnsupportedType = "unsupported_type"
+
+// Creates the error of a value of an unsupported type, expected describes the supported types
+func NewUnsupportedTypeError(field string, value any, expected string) *ValidationError {
+	return NewValidationErrorf(field, "unsupported type %T, expected %s", value, expected).
+		WithCode(CodeUnsupportedType).WithParam("expected", expected).WithValue(value)
+}
+
+// Replaces the path of the invalid field
+func (e *ValidationError) WithPath(path Path) *ValidationError {
+	e.path = path
+	e.name = ""
+	return e
+}
+
+// Prepends prefix to the path of the invalid field, i.e. to nest the errors of a child value
+func (e *ValidationError) WithPrefix(prefix Path) *ValidationError {
+	if len(prefix) == 0 {
+		return e
+	}
+	e.path = e.path.Prepend(prefix)
+	e.name = ""
+	return e
+}
+
+// Sets the machine-readable code of the error, i.e. "min_length"
+func (e *ValidationError) WithCode(code string) *ValidationError {
+	e.code = code
+	return e
+}
+
+// Sets a parameter of the rule that failed, i.e. "min" for MinLength
+func (e *ValidationError) WithParam(name string, value any) *ValidationError {
+	if e.params == nil {
+		e.params = make(map[string]any)
+	}
+	e.params[name] = value
+	return e
+}
+
+// Sets the value that failed the validation
+func (e *ValidationError) WithValue(value any) *ValidationError {
+	e.value = value
+	return e
+}
+
+// Replaces the message with the first custom message, if any
+func (e *ValidationError) WithCustomMessage(messages ...string) *ValidationError {
+	if len(messages) > 0 {
+		e.message = messages[0]
+		e.customMessage = true
+	}
+	return e
+}
+
+// Replaces the message, i.e. with a translated one
+func (e *ValidationError) WithMessage(message string) *ValidationError {
+	e.message = message
+	return e
+}
+
+// Returns the path of the invalid field in bracket notation, i.e. items[2].sku,
+// or the name it was created with if it is not written as a path
 func (e ValidationError) Field() string {
-	return e.field
+	if e.name != "" {
+		return e.name
+	}
+	return e.path.String()
+}
+
+// Returns the path of the invalid field, to be rendered in other notations
+func (e ValidationError) Path() Path {
+	return e.path
 }
 
 func (e ValidationError) Message() string {
 	return e.message
 }
+
+// Returns the machine-readable code of the error, empty if not set
+func (e ValidationError) Code() string {
+	return e.code
+}
+
+// Returns a copy of the rule parameters, i.e. {"min": 3}
+func (e ValidationError) Params() map[string]any {
+	return maps.Clone(e.params)
+}
+
+// Returns the value of a rule parameter and whether it is set
+func (e ValidationError) Param(name string) (any, bool) {
+	value, ok := e.params[name]
+	return value, ok
+}
+
+// Returns true if the message was provided by the caller instead of the validator
+func (e ValidationError) HasCustomMessage() bool {
+	return e.customMessage
+}
+
+// Returns the value that failed the validation
+func (e ValidationError) Value() any {
+	return e.value
+}
+
+type validationErrorJSON struct {
+	Field         string          `json:"field"`
+	Message       string          `json:"message"`
+	Code          string          `json:"code,omitempty"`
+	Params        map[string]any  `json:"params,omitempty"`
+	Value         json.RawMessage `json:"value,omitempty"`
+	CustomMessage bool            `json:"custom_message,omitempty"`
+}
+
+// Values that can not be encoded, i.e. channels, are encoded with fmt.Sprint
+// Decoded values and params use the encoding/json types, i.e. float64 for numbers
+func (e ValidationError) MarshalJSON() ([]byte, error) {
+	var value json.RawMessage
+	if e.value != nil {
+		encoded, err := json.Marshal(e.value)
+		if err != nil {
+			encoded, _ = json.Marshal(fmt.Sprint(e.value))
+		}
+		value = encoded
+	}
+
+	return json.Marshal(validationErrorJSON{
+		Field:         e.Field(),
+		Message:       e.message,
+		Code:          e.code,
+		Params:        e.params,
+		Value:         value,
+		CustomMessage: e.customMessage,
+	})
+}
+
+func (e *ValidationError) UnmarshalJSON(data []byte) error {
+	var decoded validationErrorJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var value any
+	if len(decoded.Value) > 0 {
+		if err := json.Unmarshal(decoded.Value, &value); err != nil {
+			return err
+		}
+	}
+
+	path, name := fieldPath(decoded.Field)
+	*e = ValidationError{
+		path:          path,
+		name:          name,
+		message:       decoded.Message,
+		code:          decoded.Code,
+		params:        decoded.Params,
+		value:         value,
+		customMessage: decoded.CustomMessage,
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package jsonschema
+
+import "github.com/Palma99/govalid"
+
+// Error codes of Validator, the keywords that match a built-in validator use its code:
+// validators.CodeMin, CodeMax, CodeMinLength, CodeMaxLength and CodeMatchesRegex
+const (
+	CodeType          = "type"
+	CodeRequired      = "required"
+	CodeEnum          = "enum"
+	CodeConst         = "const"
+	CodeExclusiveMin  = "exclusive_min"
+	CodeExclusiveMax  = "exclusive_max"
+	CodeMinItems      = "min_items"
+	CodeMaxItems      = "max_items"
+	CodeMinProperties = "min_properties"
+	CodeMaxProperties = "max_properties"
+	CodeNotAllowed    = "not_allowed"
+	CodeAnyOf         = "any_of"
+	CodeOneOf         = "one_of"
+	CodeNot           = "not"
+)
+
+// Messages of the JSON Schema error codes, registered for use with ValidationResult.Localize
+var (
+	MessagesEN = govalid.MapCatalog{
+		CodeType:          "must be of type {type}",
+		CodeRequired:      "is required",
+		CodeEnum:          "must be one of {values}",
+		CodeConst:         "must be equal to {expected}",
+		CodeExclusiveMin:  "must be greater than {min}",
+		CodeExclusiveMax:  "must be less than {max}",
+		CodeMinItems:      "must contain at least {min} items",
+		CodeMaxItems:      "must contain at most {max} items",
+		CodeMinProperties: "must contain at least {min} properties",
+		CodeMaxProperties: "must contain at most {max} properties",
+		CodeNotAllowed:    "is not allowed",
+		CodeAnyOf:         "must match at least one of the schemas",
+		CodeOneOf:         "must match exactly one of the schemas",
+		CodeNot:           "must not match the schema",
+	}
+
+	MessagesIT = govalid.MapCatalog{
+		CodeType:          "deve essere di tipo {type}",
+		CodeRequired:      "è obbligatorio",
+		CodeEnum:          "deve essere uno tra {values}",
+		CodeConst:         "deve essere uguale a {expected}",
+		CodeExclusiveMin:  "deve essere maggiore di {min}",
+		CodeExclusiveMax:  "deve essere minore di {max}",
+		CodeMinItems:      "deve contenere almeno {min} elementi",
+		CodeMaxItems:      "deve contenere al massimo {max} elementi",
+		CodeMinProperties: "deve contenere almeno {min} proprietà",
+		CodeMaxProperties: "deve contenere al massimo {max} proprietà",
+		CodeNotAllowed:    "non è consentito",
+		CodeAnyOf:         "deve corrispondere ad almeno uno degli schemi",
+		CodeOneOf:         "deve corrispondere esattamente a uno degli schemi",
+		CodeNot:           "non deve corrispondere allo schema",
+	}
+
+	MessagesDE = govalid.MapCatalog{
+		CodeType:          "muss vom Typ {type} sein",
+		CodeRequired:      "ist erforderlich",
+		CodeEnum:          "muss einer der Werte {values} sein",
+		CodeConst:         "muss gleich {expected} sein",
+		CodeExclusiveMin:  "muss größer als {min} sein",
+		CodeExclusiveMax:  "muss kleiner als {max} sein",
+		CodeMinItems:      "muss mindestens {min} Elemente enthalten",
+		CodeMaxItems:      "darf höchstens {max} Elemente enthalten",
+		CodeMinProperties: "muss mindestens {min} Eigenschaften enthalten",
+		CodeMaxProperties: "darf höchstens {max} Eigenschaften enthalten",
+		CodeNotAllowed:    "ist nicht erlaubt",
+		CodeAnyOf:         "muss mindestens einem der Schemas entsprechen",
+		CodeOneOf:         "muss genau einem der Schemas entsprechen",
+		CodeNot:           "darf dem Schema nicht entsprechen",
+	}
+)
+
+func init() {
+	govalid.RegisterCatalog("en", MessagesEN)
+	govalid.RegisterCatalog("it", MessagesIT)
+	govalid.RegisterCatalog("de", MessagesDE)
+}
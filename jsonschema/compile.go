@@ -0,0 +1,535 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Palma99/govalid/internal/utils"
+)
+
+// CompileError reports an invalid schema document, Pointer locates the problem in the document
+type CompileError struct {
+	Pointer string
+	Message string
+}
+
+func (e *CompileError) Error() string {
+	return fmt.Sprintf("jsonschema: %s: %s", pointerOrRoot(e.Pointer), e.Message)
+}
+
+func pointerOrRoot(pointer string) string {
+	if pointer == "" {
+		return "#"
+	}
+	return pointer
+}
+
+var jsonTypes = []string{"null", "boolean", "object", "array", "number", "string", "integer"}
+
+// A compiled schema, keywords that are not present are nil
+type node struct {
+	boolean *bool
+
+	types      []string
+	enum       []any
+	hasConst   bool
+	constValue any
+
+	minLength *int
+	maxLength *int
+	pattern   *regexp.Regexp
+
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum *float64
+	exclusiveMaximum *float64
+
+	items    *node
+	minItems *int
+	maxItems *int
+
+	required             []string
+	properties           map[string]*node
+	propertyNames        []string
+	additionalProperties *node
+	minProperties        *int
+	maxProperties        *int
+
+	allOf []*node
+	anyOf []*node
+	oneOf []*node
+	not   *node
+	ref   *node
+}
+
+type compiler struct {
+	root  any
+	nodes map[string]*node
+}
+
+// Compiles a JSON Schema document, the draft 2020-12 keywords available are:
+//
+//	type, enum, const
+//	minLength, maxLength, pattern
+//	minimum, maximum, exclusiveMinimum, exclusiveMaximum
+//	items, minItems, maxItems
+//	required, properties, additionalProperties, minProperties, maxProperties
+//	allOf, anyOf, oneOf, not
+//	$ref, $defs, definitions
+//
+// Other keywords, like format, are annotations and are ignored
+// $ref can only reference the document itself, i.e. "#/$defs/address", nothing is fetched
+// Patterns use Go regular expressions, ECMA-262 features like lookarounds are not supported
+func Compile(data []byte) (*Validator, error) {
+	root, err := decode(data)
+	if err != nil {
+		return nil, &CompileError{Message: err.Error()}
+	}
+
+	c := &compiler{root: root, nodes: map[string]*node{}}
+	n, err := c.compile(root, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkCycles(); err != nil {
+		return nil, err
+	}
+
+	return &Validator{root: n}, nil
+}
+
+// Decodes a single JSON value, keeping numbers exact
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return v, nil
+}
+
+// Nodes are cached by location, so recursive references point to the same node
+func (c *compiler) compile(raw any, pointer string) (*node, error) {
+	if n, ok := c.nodes[pointer]; ok {
+		return n, nil
+	}
+
+	n := &node{}
+	c.nodes[pointer] = n
+
+	switch schema := raw.(type) {
+	case bool:
+		n.boolean = &schema
+		return n, nil
+	case map[string]any:
+		return n, c.compileKeywords(n, schema, pointer)
+	default:
+		return nil, c.errorf(pointer, "a schema must be an object or a boolean")
+	}
+}
+
+func (c *compiler) compileKeywords(n *node, schema map[string]any, pointer string) error {
+	var err error
+
+	for _, key := range []string{"$defs", "definitions"} {
+		if defs, ok := schema[key]; ok {
+			if _, err := c.compileMap(defs, pointer+"/"+key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if ref, ok := schema["$ref"]; ok {
+		if n.ref, err = c.compileRef(ref, pointer+"/$ref"); err != nil {
+			return err
+		}
+	}
+
+	if types, ok := schema["type"]; ok {
+		if n.types, err = c.types(types, pointer+"/type"); err != nil {
+			return err
+		}
+	}
+
+	if enum, ok := schema["enum"]; ok {
+		values, ok := enum.([]any)
+		if !ok {
+			return c.errorf(pointer+"/enum", "must be an array")
+		}
+		for _, value := range values {
+			n.enum = append(n.enum, normalize(value))
+		}
+	}
+
+	if value, ok := schema["const"]; ok {
+		n.hasConst, n.constValue = true, normalize(value)
+	}
+
+	intKeywords := []struct {
+		key    string
+		target **int
+	}{
+		{"minLength", &n.minLength},
+		{"maxLength", &n.maxLength},
+		{"minItems", &n.minItems},
+		{"maxItems", &n.maxItems},
+		{"minProperties", &n.minProperties},
+		{"maxProperties", &n.maxProperties},
+	}
+	for _, keyword := range intKeywords {
+		if value, ok := schema[keyword.key]; ok {
+			if *keyword.target, err = c.nonNegativeInt(value, pointer+"/"+keyword.key); err != nil {
+				return err
+			}
+		}
+	}
+
+	numberKeywords := []struct {
+		key    string
+		target **float64
+	}{
+		{"minimum", &n.minimum},
+		{"maximum", &n.maximum},
+		{"exclusiveMinimum", &n.exclusiveMinimum},
+		{"exclusiveMaximum", &n.exclusiveMaximum},
+	}
+	for _, keyword := range numberKeywords {
+		if value, ok := schema[keyword.key]; ok {
+			number, ok := toNumber(value)
+			if !ok {
+				return c.errorf(pointer+"/"+keyword.key, "must be a number")
+			}
+			*keyword.target = &number
+		}
+	}
+
+	if pattern, ok := schema["pattern"]; ok {
+		source, ok := pattern.(string)
+		if !ok {
+			return c.errorf(pointer+"/pattern", "must be a string")
+		}
+		if n.pattern, err = regexp.Compile(source); err != nil {
+			return c.errorf(pointer+"/pattern", "invalid pattern: %v", err)
+		}
+	}
+
+	if required, ok := schema["required"]; ok {
+		if n.required, err = c.strings(required, pointer+"/required"); err != nil {
+			return err
+		}
+	}
+
+	if properties, ok := schema["properties"]; ok {
+		if n.properties, err = c.compileMap(properties, pointer+"/properties"); err != nil {
+			return err
+		}
+		for name := range n.properties {
+			n.propertyNames = append(n.propertyNames, name)
+		}
+		slices.Sort(n.propertyNames)
+	}
+
+	subschemas := []struct {
+		key    string
+		target **node
+	}{
+		{"additionalProperties", &n.additionalProperties},
+		{"items", &n.items},
+		{"not", &n.not},
+	}
+	for _, keyword := range subschemas {
+		if value, ok := schema[keyword.key]; ok {
+			if _, isArray := value.([]any); isArray && keyword.key == "items" {
+				return c.errorf(pointer+"/items", "the array form of items is not supported")
+			}
+			if *keyword.target, err = c.compile(value, pointer+"/"+keyword.key); err != nil {
+				return err
+			}
+		}
+	}
+
+	combinators := []struct {
+		key    string
+		target *[]*node
+	}{
+		{"allOf", &n.allOf},
+		{"anyOf", &n.anyOf},
+		{"oneOf", &n.oneOf},
+	}
+	for _, keyword := range combinators {
+		if value, ok := schema[keyword.key]; ok {
+			if *keyword.target, err = c.compileList(value, pointer+"/"+keyword.key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *compiler) compileRef(raw any, pointer string) (*node, error) {
+	ref, ok := raw.(string)
+	if !ok {
+		return nil, c.errorf(pointer, "must be a string")
+	}
+	if !strings.HasPrefix(ref, "#") {
+		return nil, c.errorf(pointer, "remote reference %q is not supported", ref)
+	}
+
+	target, err := url.PathUnescape(ref[1:])
+	if err != nil {
+		return nil, c.errorf(pointer, "invalid reference %q", ref)
+	}
+
+	value, ok := resolvePointer(c.root, target)
+	if !ok {
+		return nil, c.errorf(pointer, "reference %q not found", ref)
+	}
+	return c.compile(value, target)
+}
+
+// Rejects $ref chains looping back to a schema without going through an object or
+// array keyword, validating them would never end as the value never changes
+func (c *compiler) checkCycles() error {
+	pointers := make(map[*node]string, len(c.nodes))
+	for pointer, n := range c.nodes {
+		pointers[n] = pointer
+	}
+
+	const (
+		visiting = iota + 1
+		done
+	)
+	state := map[*node]int{}
+	var stack []*node
+
+	var visit func(n *node) error
+	visit = func(n *node) error {
+		state[n] = visiting
+		stack = append(stack, n)
+
+		for _, next := range n.inPlace() {
+			switch state[next] {
+			case visiting:
+				cycle := append(stack[slices.Index(stack, next):], next)
+				// Schemas only nest inside each other, so the loop goes through a $ref
+				for i := len(cycle) - 2; i >= 0; i-- {
+					if cycle[i].ref == cycle[i+1] {
+						return c.errorf(pointers[cycle[i]]+"/$ref", "circular reference %q, it must go through an object or array keyword", "#"+pointers[next])
+					}
+				}
+			case 0:
+				if err := visit(next); err != nil {
+					return err
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[n] = done
+		return nil
+	}
+
+	sorted := make([]string, 0, len(c.nodes))
+	for pointer := range c.nodes {
+		sorted = append(sorted, pointer)
+	}
+	slices.Sort(sorted)
+
+	for _, pointer := range sorted {
+		if n := c.nodes[pointer]; state[n] == 0 {
+			if err := visit(n); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the subschemas applied to the same value as the schema
+func (n *node) inPlace() []*node {
+	var nodes []*node
+	if n.ref != nil {
+		nodes = append(nodes, n.ref)
+	}
+	nodes = append(nodes, n.allOf...)
+	nodes = append(nodes, n.anyOf...)
+	nodes = append(nodes, n.oneOf...)
+	if n.not != nil {
+		nodes = append(nodes, n.not)
+	}
+	return nodes
+}
+
+func (c *compiler) compileMap(raw any, pointer string) (map[string]*node, error) {
+	schemas, ok := raw.(map[string]any)
+	if !ok {
+		return nil, c.errorf(pointer, "must be an object")
+	}
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	nodes := make(map[string]*node, len(schemas))
+	for _, name := range names {
+		n, err := c.compile(schemas[name], pointer+"/"+escape(name))
+		if err != nil {
+			return nil, err
+		}
+		nodes[name] = n
+	}
+	return nodes, nil
+}
+
+func (c *compiler) compileList(raw any, pointer string) ([]*node, error) {
+	schemas, ok := raw.([]any)
+	if !ok || len(schemas) == 0 {
+		return nil, c.errorf(pointer, "must be a non-empty array")
+	}
+
+	nodes := make([]*node, 0, len(schemas))
+	for i, schema := range schemas {
+		n, err := c.compile(schema, pointer+"/"+strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+func (c *compiler) types(raw any, pointer string) ([]string, error) {
+	if name, ok := raw.(string); ok {
+		raw = []any{name}
+	}
+
+	types, err := c.strings(raw, pointer)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range types {
+		if !slices.Contains(jsonTypes, name) {
+			return nil, c.errorf(pointer, "unknown type %q", name)
+		}
+	}
+	return types, nil
+}
+
+func (c *compiler) strings(raw any, pointer string) ([]string, error) {
+	values, ok := raw.([]any)
+	if !ok {
+		return nil, c.errorf(pointer, "must be an array of strings")
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			return nil, c.errorf(pointer, "must be an array of strings")
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func (c *compiler) nonNegativeInt(raw any, pointer string) (*int, error) {
+	number, ok := toNumber(raw)
+	if !ok || number < 0 || number != float64(int(number)) {
+		return nil, c.errorf(pointer, "must be a non-negative integer")
+	}
+
+	n := int(number)
+	return &n, nil
+}
+
+func (c *compiler) errorf(pointer, format string, args ...any) error {
+	return &CompileError{Pointer: pointer, Message: fmt.Sprintf(format, args...)}
+}
+
+// Returns the value at a JSON pointer, i.e. "/$defs/address"
+func resolvePointer(root any, pointer string) (any, bool) {
+	if pointer == "" {
+		return root, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+
+	current := root
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		switch v := current.(type) {
+		case map[string]any:
+			next, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			current = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func escape(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// Converts json.Number and Go numbers to float64
+func toNumber(value any) (float64, bool) {
+	if number, ok := value.(json.Number); ok {
+		f, err := number.Float64()
+		return f, err == nil
+	}
+	if _, ok := value.(bool); ok {
+		return 0, false
+	}
+	return utils.ToFloat64(value)
+}
+
+// Numbers are compared as float64, so 1 and 1.0 are equal
+func normalize(value any) any {
+	switch v := value.(type) {
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = normalize(item)
+		}
+		return items
+	case map[string]any:
+		members := make(map[string]any, len(v))
+		for name, member := range v {
+			members[name] = normalize(member)
+		}
+		return members
+	}
+
+	if number, ok := toNumber(value); ok {
+		return number
+	}
+	return value
+}
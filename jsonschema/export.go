@@ -0,0 +1,384 @@
+package jsonschema
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/schema"
+)
+
+// Rules map to different keywords for strings, arrays and maps
+type kind int
+
+const (
+	anyKind kind = iota
+	stringKind
+	arrayKind
+	mapKind
+	numberKind
+	otherKind
+)
+
+// Converts a schema loaded by the schema package
+// Field types are not known, so length rules map to both the string and the array keywords
+// Dotted field names become nested objects, indexed names like "items[0]" are not supported
+//
+// Rules are mapped as follows, any other rule is returned as Unsupported:
+//
+//	required   required, plus minLength 1 and pattern \S for strings, minItems 1, minProperties 1
+//	min_len    minLength, minItems, minProperties
+//	max_len    maxLength, maxItems, maxProperties
+//	min, max   minimum, maximum
+//	matches    pattern, Go regular expressions are mostly compatible with the ECMA-262 ones
+//	email      format: email
+//
+// Lengths of govalid strings are in bytes, JSON Schema counts characters, so limits
+// differ for non-ASCII strings
+func FromSchema(s *schema.Schema) (*Schema, []Unsupported) {
+	root := &Schema{Schema: Draft, Type: "object"}
+	var unsupported []Unsupported
+
+	for _, name := range s.Fields() {
+		target, markRequired, ok := property(root, govalid.ParsePath(name))
+
+		for _, r := range s.Rules(name) {
+			reason := "indexed paths are not supported"
+			if ok {
+				reason = applyRule(target, markRequired, r.Name, r.Param, anyKind)
+			}
+			if reason != "" {
+				unsupported = append(unsupported, Unsupported{Field: name, Rule: ruleSpec(r.Name, r.Param), Reason: reason})
+			}
+		}
+	}
+
+	return root, unsupported
+}
+
+// Returns the schema of the property at path, creating the intermediate objects
+// A required nested field also requires its parents, as a missing parent has no value to check
+func property(root *Schema, path govalid.Path) (*Schema, func(), bool) {
+	var parents []*Schema
+	var names []string
+
+	current := root
+	for _, segment := range path {
+		if segment.Kind() == govalid.IndexKind {
+			return nil, nil, false
+		}
+
+		if current.Properties == nil {
+			current.Properties = map[string]*Schema{}
+		}
+		next, ok := current.Properties[segment.String()]
+		if !ok {
+			next = &Schema{}
+			current.Properties[segment.String()] = next
+		}
+
+		parents = append(parents, current)
+		names = append(names, segment.String())
+		current = next
+	}
+
+	// Intermediate values are objects, the leaf type is not known
+	for _, parent := range parents[1:] {
+		parent.Type = "object"
+	}
+
+	markRequired := func() {
+		for i, parent := range parents {
+			addRequired(parent, names[i])
+		}
+	}
+	return current, markRequired, true
+}
+
+// Converts a struct type using its `validate` tags, v is a struct or a pointer to a struct,
+// even a nil one, i.e. (*Person)(nil). Properties use the json name of the fields
+// Named nested structs are added to $defs, so recursive types are supported
+// Rules are mapped as in FromSchema, using the field type to pick the keywords
+// It panics if v is not a struct
+func FromStruct(v any) (*Schema, []Unsupported) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("jsonschema.FromStruct: unsupported type %T", v))
+	}
+
+	e := &structExporter{defs: map[string]*Schema{}, names: map[reflect.Type]string{t: ""}}
+	root := e.structSchema(t)
+	root.Schema = Draft
+	if len(e.defs) > 0 {
+		root.Defs = e.defs
+	}
+
+	return root, e.unsupported
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+var invalidDefChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+type structExporter struct {
+	defs        map[string]*Schema
+	names       map[reflect.Type]string
+	unsupported []Unsupported
+}
+
+func (e *structExporter) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || jsonName == "-" {
+			continue
+		}
+
+		name := field.Name
+		if jsonName != "" {
+			name = jsonName
+		}
+
+		fieldSchema, k := e.typeSchema(field.Type)
+		required := false
+
+		if tag := field.Tag.Get("validate"); tag != "-" {
+			for _, entry := range govalid.SplitTag(tag) {
+				ruleName, param := govalid.ParseRuleSpec(entry)
+				markRequired := func() {
+					required = true
+					addRequired(s, name)
+				}
+
+				if reason := applyRule(fieldSchema, markRequired, ruleName, param, k); reason != "" {
+					e.unsupported = append(e.unsupported, Unsupported{Field: typeName(t) + "." + name, Rule: entry, Reason: reason})
+				}
+			}
+		}
+
+		// nil pointers, slices and maps are encoded as null, unless they are required
+		if !required && isNullable(field.Type) {
+			fieldSchema = withNull(fieldSchema)
+		}
+
+		s.Properties[name] = fieldSchema
+	}
+
+	return s
+}
+
+func (e *structExporter) typeSchema(t reflect.Type) (*Schema, kind) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}, otherKind
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}, stringKind
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, otherKind
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer"}, numberKind
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, numberKind
+	case reflect.Slice, reflect.Array:
+		// []byte is encoded as a base64 string
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string"}, otherKind
+		}
+		items, _ := e.typeSchema(t.Elem())
+		return &Schema{Type: "array", Items: items}, arrayKind
+	case reflect.Map:
+		values, _ := e.typeSchema(t.Elem())
+		return &Schema{Type: "object", AdditionalProperties: values}, mapKind
+	case reflect.Struct:
+		if t.Name() == "" {
+			return e.structSchema(t), otherKind
+		}
+		return &Schema{Ref: e.ref(t)}, otherKind
+	case reflect.Interface:
+		return &Schema{}, anyKind
+	default:
+		return &Schema{}, otherKind
+	}
+}
+
+// Returns the reference to the definition of a named struct, adding it to $defs the first time
+func (e *structExporter) ref(t reflect.Type) string {
+	if name, ok := e.names[t]; ok {
+		return refTo(name)
+	}
+
+	name := invalidDefChars.ReplaceAllString(t.Name(), "_")
+	for i := 2; e.defs[name] != nil; i++ {
+		name = fmt.Sprintf("%s%d", invalidDefChars.ReplaceAllString(t.Name(), "_"), i)
+	}
+
+	// The name is reserved before walking the fields, so recursive types reference it
+	e.names[t] = name
+	e.defs[name] = &Schema{}
+	*e.defs[name] = *e.structSchema(t)
+
+	return refTo(name)
+}
+
+// The root struct has no name, it is referenced as the whole document
+func refTo(name string) string {
+	if name == "" {
+		return "#"
+	}
+	return "#/$defs/" + name
+}
+
+func typeName(t reflect.Type) string {
+	if t.Name() != "" {
+		return t.Name()
+	}
+	return t.String()
+}
+
+// Maps a rule to JSON Schema keywords, it returns why the rule is not supported, if it is not
+func applyRule(s *Schema, markRequired func(), name, param string, k kind) string {
+	switch name {
+	case "required":
+		markRequired()
+		if k == stringKind || k == anyKind {
+			s.MinLength = intPtr(max(1, deref(s.MinLength)))
+			addPattern(s, `\S`)
+		}
+		if k == arrayKind || k == anyKind {
+			s.MinItems = intPtr(max(1, deref(s.MinItems)))
+		}
+		if k == mapKind || k == anyKind {
+			s.MinProperties = intPtr(max(1, deref(s.MinProperties)))
+		}
+	case "min_len", "max_len":
+		n, err := strconv.Atoi(strings.TrimSpace(param))
+		if err != nil {
+			return "invalid parameter"
+		}
+
+		isMin := name == "min_len"
+		if k == stringKind || k == anyKind {
+			setLimit(&s.MinLength, &s.MaxLength, isMin, n)
+		}
+		if k == arrayKind || k == anyKind {
+			setLimit(&s.MinItems, &s.MaxItems, isMin, n)
+		}
+		if k == mapKind || k == anyKind {
+			setLimit(&s.MinProperties, &s.MaxProperties, isMin, n)
+		}
+		if k == numberKind || k == otherKind {
+			return "length of a value without length"
+		}
+	case "min", "max":
+		n, err := strconv.ParseFloat(strings.TrimSpace(param), 64)
+		if err != nil {
+			return "invalid parameter"
+		}
+		if name == "min" {
+			s.Minimum = &n
+		} else {
+			s.Maximum = &n
+		}
+	case "matches":
+		addPattern(s, param)
+	case "email":
+		s.Format = "email"
+	case "hostname", "ipv4", "ipv6":
+		s.Format = name
+	case "url":
+		if param != "" {
+			return "allowed schemes have no JSON Schema equivalent"
+		}
+		s.Format = "uri"
+	case "uuid":
+		if param != "" {
+			return "UUID versions have no JSON Schema equivalent"
+		}
+		s.Format = "uuid"
+	default:
+		return "no JSON Schema equivalent"
+	}
+
+	return ""
+}
+
+func setLimit(min, max **int, isMin bool, n int) {
+	if isMin {
+		*min = intPtr(n)
+	} else {
+		*max = intPtr(n)
+	}
+}
+
+// A schema has a single pattern keyword, more patterns are added with allOf
+func addPattern(s *Schema, pattern string) {
+	if s.Pattern == "" {
+		s.Pattern = pattern
+		return
+	}
+	s.AllOf = append(s.AllOf, &Schema{Pattern: pattern})
+}
+
+func addRequired(s *Schema, name string) {
+	for _, required := range s.Required {
+		if required == name {
+			return
+		}
+	}
+	s.Required = append(s.Required, name)
+}
+
+func isNullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		return true
+	}
+	return false
+}
+
+func withNull(s *Schema) *Schema {
+	switch t := s.Type.(type) {
+	case string:
+		s.Type = []string{t, "null"}
+		return s
+	case nil:
+		if s.Ref != "" {
+			return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+		}
+	}
+	return s
+}
+
+func ruleSpec(name, param string) string {
+	if param == "" {
+		return name
+	}
+	return name + "=" + param
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func deref(n *int) int {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
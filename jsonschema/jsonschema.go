@@ -0,0 +1,42 @@
+// Package jsonschema converts govalid rules to JSON Schema (draft 2020-12) documents,
+// and validates decoded JSON against JSON Schema documents
+package jsonschema
+
+// Draft is the dialect of the exported documents
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document, only the keywords govalid rules map to are available
+// Type is a string, or a []string when more types are allowed, i.e. ["string", "null"]
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+}
+
+// Unsupported is a rule that has no JSON Schema equivalent, so it is missing from the document
+type Unsupported struct {
+	Field  string
+	Rule   string
+	Reason string
+}
+
+func (u Unsupported) String() string {
+	return u.Field + ": " + u.Rule + ": " + u.Reason
+}
@@ -0,0 +1,254 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+)
+
+// Validator validates decoded JSON against a compiled JSON Schema document
+// It is immutable, so it can be shared and used concurrently
+type Validator struct {
+	root *node
+}
+
+// Validates a value decoded by encoding/json into an any: nil, bool, float64 or
+// json.Number, string, []any and map[string]any
+// Errors are located by their path, use err.Path().JSONPointer() for JSON pointers,
+// i.e. "/items/2/sku"; errors on the document itself have an empty path
+// Properties are checked in name order, as decoded objects do not keep the document order
+func (v *Validator) Validate(value any) govalid.ValidationResult {
+	var errs []govalid.ValidationError
+	v.root.validate(value, nil, &errs)
+	return govalid.NewValidationResult(errs...)
+}
+
+// Decodes and validates a JSON document, it returns an error if data is not valid JSON
+func (v *Validator) ValidateJSON(data []byte) (govalid.ValidationResult, error) {
+	value, err := decode(data)
+	if err != nil {
+		return govalid.ValidationResult{}, err
+	}
+	return v.Validate(value), nil
+}
+
+func (n *node) validate(value any, path govalid.Path, errs *[]govalid.ValidationError) {
+	fail := func(path govalid.Path, code, message string) *govalid.ValidationError {
+		err := govalid.NewValidationError("", message).WithPath(path).WithCode(code)
+		*errs = append(*errs, *err)
+		return &(*errs)[len(*errs)-1]
+	}
+
+	if n.boolean != nil {
+		if !*n.boolean {
+			fail(path, CodeNotAllowed, "is not allowed")
+		}
+		return
+	}
+
+	if n.ref != nil {
+		n.ref.validate(value, path, errs)
+	}
+
+	if len(n.types) > 0 && !slices.ContainsFunc(n.types, func(t string) bool { return hasType(value, t) }) {
+		types := strings.Join(n.types, " or ")
+		fail(path, CodeType, "must be of type "+types).WithParam("type", types).WithValue(value)
+	}
+
+	if n.enum != nil && !slices.ContainsFunc(n.enum, func(allowed any) bool { return equal(value, allowed) }) {
+		values := formatValues(n.enum)
+		fail(path, CodeEnum, "must be one of "+values).WithParam("values", values).WithValue(value)
+	}
+
+	if n.hasConst && !equal(value, n.constValue) {
+		expected := formatValue(n.constValue)
+		fail(path, CodeConst, "must be equal to "+expected).WithParam("expected", expected).WithValue(value)
+	}
+
+	switch v := value.(type) {
+	case string:
+		n.validateString(v, path, fail)
+	case []any:
+		n.validateArray(v, path, errs, fail)
+	case map[string]any:
+		n.validateObject(v, path, errs, fail)
+	default:
+		if number, ok := toNumber(value); ok {
+			n.validateNumber(number, path, fail)
+		}
+	}
+
+	for _, schema := range n.allOf {
+		schema.validate(value, path, errs)
+	}
+
+	if n.anyOf != nil && countValid(n.anyOf, value) == 0 {
+		fail(path, CodeAnyOf, "must match at least one of the schemas")
+	}
+
+	if n.oneOf != nil {
+		if matches := countValid(n.oneOf, value); matches != 1 {
+			fail(path, CodeOneOf, "must match exactly one of the schemas").WithParam("matches", matches)
+		}
+	}
+
+	if n.not != nil && countValid([]*node{n.not}, value) == 1 {
+		fail(path, CodeNot, "must not match the schema")
+	}
+}
+
+type failFunc func(path govalid.Path, code, message string) *govalid.ValidationError
+
+func (n *node) validateString(value string, path govalid.Path, fail failFunc) {
+	// JSON Schema lengths count characters, not bytes
+	length := utf8.RuneCountInString(value)
+
+	if n.minLength != nil && length < *n.minLength {
+		fail(path, validators.CodeMinLength, fmt.Sprintf("must be at least %d characters", *n.minLength)).
+			WithParam("min", *n.minLength).WithValue(value)
+	}
+	if n.maxLength != nil && length > *n.maxLength {
+		fail(path, validators.CodeMaxLength, fmt.Sprintf("must be at most %d characters", *n.maxLength)).
+			WithParam("max", *n.maxLength).WithValue(value)
+	}
+	if n.pattern != nil && !n.pattern.MatchString(value) {
+		fail(path, validators.CodeMatchesRegex, "must match pattern "+n.pattern.String()).
+			WithParam("pattern", n.pattern.String()).WithValue(value)
+	}
+}
+
+func (n *node) validateNumber(value float64, path govalid.Path, fail failFunc) {
+	if n.minimum != nil && value < *n.minimum {
+		fail(path, validators.CodeMin, fmt.Sprintf("must be at least %v", *n.minimum)).
+			WithParam("min", *n.minimum).WithValue(value)
+	}
+	if n.maximum != nil && value > *n.maximum {
+		fail(path, validators.CodeMax, fmt.Sprintf("must be at most %v", *n.maximum)).
+			WithParam("max", *n.maximum).WithValue(value)
+	}
+	if n.exclusiveMinimum != nil && value <= *n.exclusiveMinimum {
+		fail(path, CodeExclusiveMin, fmt.Sprintf("must be greater than %v", *n.exclusiveMinimum)).
+			WithParam("min", *n.exclusiveMinimum).WithValue(value)
+	}
+	if n.exclusiveMaximum != nil && value >= *n.exclusiveMaximum {
+		fail(path, CodeExclusiveMax, fmt.Sprintf("must be less than %v", *n.exclusiveMaximum)).
+			WithParam("max", *n.exclusiveMaximum).WithValue(value)
+	}
+}
+
+func (n *node) validateArray(value []any, path govalid.Path, errs *[]govalid.ValidationError, fail failFunc) {
+	if n.minItems != nil && len(value) < *n.minItems {
+		fail(path, CodeMinItems, fmt.Sprintf("must contain at least %d items", *n.minItems)).WithParam("min", *n.minItems)
+	}
+	if n.maxItems != nil && len(value) > *n.maxItems {
+		fail(path, CodeMaxItems, fmt.Sprintf("must contain at most %d items", *n.maxItems)).WithParam("max", *n.maxItems)
+	}
+
+	if n.items != nil {
+		for i, item := range value {
+			n.items.validate(item, path.Append(govalid.IndexSegment(i)), errs)
+		}
+	}
+}
+
+func (n *node) validateObject(value map[string]any, path govalid.Path, errs *[]govalid.ValidationError, fail failFunc) {
+	if n.minProperties != nil && len(value) < *n.minProperties {
+		fail(path, CodeMinProperties, fmt.Sprintf("must contain at least %d properties", *n.minProperties)).
+			WithParam("min", *n.minProperties)
+	}
+	if n.maxProperties != nil && len(value) > *n.maxProperties {
+		fail(path, CodeMaxProperties, fmt.Sprintf("must contain at most %d properties", *n.maxProperties)).
+			WithParam("max", *n.maxProperties)
+	}
+
+	// Missing properties are reported on their own path, like empty fields
+	for _, name := range n.required {
+		if _, ok := value[name]; !ok {
+			fail(path.Append(govalid.FieldSegment(name)), CodeRequired, "is required")
+		}
+	}
+
+	for _, name := range n.propertyNames {
+		if member, ok := value[name]; ok {
+			n.properties[name].validate(member, path.Append(govalid.FieldSegment(name)), errs)
+		}
+	}
+
+	if n.additionalProperties != nil {
+		names := make([]string, 0, len(value))
+		for name := range value {
+			if _, ok := n.properties[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+
+		for _, name := range names {
+			n.additionalProperties.validate(value[name], path.Append(govalid.FieldSegment(name)), errs)
+		}
+	}
+}
+
+func countValid(schemas []*node, value any) int {
+	count := 0
+	for _, schema := range schemas {
+		var errs []govalid.ValidationError
+		if schema.validate(value, nil, &errs); len(errs) == 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func hasType(value any, name string) bool {
+	switch name {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "number":
+		_, ok := toNumber(value)
+		return ok
+	case "integer":
+		number, ok := toNumber(value)
+		return ok && !math.IsInf(number, 0) && number == math.Trunc(number)
+	}
+	return false
+}
+
+func equal(value, expected any) bool {
+	return reflect.DeepEqual(normalize(value), expected)
+}
+
+func formatValues(values []any) string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, formatValue(value))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func formatValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
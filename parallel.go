@@ -0,0 +1,118 @@
+package govalid
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelOptions configures ValidateParallel
+type ParallelOptions struct {
+	// Maximum number of validations running at the same time, defaults to runtime.GOMAXPROCS(0)
+	Workers int
+	// Returns only the first error, as ValidateShortCircuit does
+	FailFast bool
+}
+
+// Runs validations using a bounded pool of goroutines
+// It accepts the same types as ValidateCtx, ValidationFuncCtx validations receive a context
+// that is cancelled when their result is no longer needed
+//
+// Errors are returned in the same order Validate would return them. In fail-fast mode
+// the result is the same as ValidateShortCircuit: once an error is found, validations
+// coming after it are cancelled or not started, while the ones before it still run
+// because they could fail too
+func ValidateParallel(opts ParallelOptions, validations ...any) ValidationResult {
+	funcs, _ := flattenCtx("ValidateParallel", opts.FailFast, validations...)
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(funcs))
+
+	var (
+		errs    = make([][]ValidationError, len(funcs))
+		cancels = make([]context.CancelFunc, len(funcs))
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		// Index of the first error found so far, only used in fail-fast mode
+		firstError atomic.Int64
+	)
+	firstError.Store(int64(len(funcs)))
+
+	isNeeded := func(i int) bool {
+		return !opts.FailFast || int64(i) < firstError.Load()
+	}
+
+	jobs := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				ctx, cancel := context.WithCancel(context.Background())
+				// Checked and registered together, so an error found meanwhile either stops the
+				// validation here or finds its cancel function
+				mu.Lock()
+				needed := isNeeded(i)
+				if needed {
+					cancels[i] = cancel
+				}
+				mu.Unlock()
+				if !needed {
+					cancel()
+					continue
+				}
+
+				errs[i] = funcs[i](ctx)
+				cancel()
+				if len(errs[i]) == 0 {
+					continue
+				}
+
+				if opts.FailFast {
+					mu.Lock()
+					lowerFirstError(&firstError, i)
+					for j := i + 1; j < len(cancels); j++ {
+						if cancels[j] != nil {
+							cancels[j]()
+						}
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := range funcs {
+		if !isNeeded(i) {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	result := NewValidationResult()
+	for _, funcErrs := range errs {
+		for _, err := range funcErrs {
+			result.addError(err)
+		}
+		if opts.FailFast && len(funcErrs) > 0 {
+			break
+		}
+	}
+
+	return result
+}
+
+func lowerFirstError(firstError *atomic.Int64, i int) {
+	for {
+		current := firstError.Load()
+		if int64(i) >= current || firstError.CompareAndSwap(current, int64(i)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package govalid
+
+import (
+	"fmt"
+
+	"github.com/Palma99/govalid/internal"
+)
+
+// Path locates a field inside a nested structure, see ValidationError.Path()
+type Path = internal.Path
+
+type PathSegment = internal.PathSegment
+
+// SegmentKind tells fields, indexes and map keys apart, see PathSegment.Kind()
+type SegmentKind = internal.SegmentKind
+
+const (
+	FieldKind = internal.FieldKind
+	IndexKind = internal.IndexKind
+	KeyKind   = internal.KeyKind
+)
+
+// Creates a path from its segments, i.e.
+//
+//	govalid.NewPath(govalid.FieldSegment("items"), govalid.IndexSegment(2), govalid.FieldSegment("sku"))
+func NewPath(segments ...PathSegment) Path {
+	return internal.NewPath(segments...)
+}
+
+// Parses a path in bracket or dotted notation, i.e. "items[2].sku"
+func ParsePath(path string) Path {
+	return internal.ParsePath(path)
+}
+
+// A struct field or object member segment
+func FieldSegment(name string) PathSegment {
+	return internal.FieldSegment(name)
+}
+
+// A slice or array index segment
+func IndexSegment(index int) PathSegment {
+	return internal.IndexSegment(index)
+}
+
+// A map key segment
+func KeySegment(key string) PathSegment {
+	return internal.KeySegment(key)
+}
+
+// Nests validations under prefix, prepending it to the path of their errors
+// It accepts ValidationFunc and/or []ValidationFunc and panics if other type is passed,
+// Validatable values can return several errors so they are passed to Validate instead
+//
+//	govalid.Validate(
+//		govalid.Nest("address", govalid.Group("city", order.Address.City,
+//			validators.NonEmptyRule(),
+//		)),
+//	)
+func Nest(prefix string, validations ...any) []ValidationFunc {
+	prefixPath := internal.ParsePath(prefix)
+
+	funcs := make([]ValidationFunc, 0, len(validations))
+	for _, v := range validations {
+		switch validator := v.(type) {
+		case ValidationFunc:
+			funcs = append(funcs, nestValidation(prefixPath, validator))
+		case []ValidationFunc:
+			for _, validation := range validator {
+				funcs = append(funcs, nestValidation(prefixPath, validation))
+			}
+		default:
+			panic(fmt.Sprintf("Nest: unsupported type %T", v))
+		}
+	}
+	return funcs
+}
+
+func nestValidation(prefix Path, validation ValidationFunc) ValidationFunc {
+	return internal.MapError(validation, func(e *internal.ValidationError) { e.WithPrefix(prefix) })
+}
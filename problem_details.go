@@ -0,0 +1,83 @@
+package govalid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const ProblemDetailsContentType = "application/problem+json"
+
+// ProblemDetails is an RFC 9457 problem, with the validation errors in the "errors" extension member
+type ProblemDetails struct {
+	Type     string         `json:"type,omitempty"`
+	Title    string         `json:"title,omitempty"`
+	Status   int            `json:"status,omitempty"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors"`
+}
+
+// ProblemError describes a single validation error of a ProblemDetails
+type ProblemError struct {
+	Field   string         `json:"field"`
+	Code    string         `json:"code,omitempty"`
+	Message string         `json:"message"`
+	Params  map[string]any `json:"params,omitempty"`
+	// JSON Pointer (RFC 6901) to the invalid member of the request body
+	Pointer string `json:"pointer"`
+}
+
+// Status of the problems created or written without one
+const defaultProblemStatus = http.StatusBadRequest
+
+// Creates the problem details of a validation result, status is usually 400 or 422,
+// 0 defaults to 400. Values are not included, as they could contain sensitive data
+func NewProblemDetails(status int, result ValidationResult) ProblemDetails {
+	if status == 0 {
+		status = defaultProblemStatus
+	}
+
+	problem := ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Errors: make([]ProblemError, 0, result.ErrorCount()),
+	}
+
+	switch count := result.ErrorCount(); count {
+	case 1:
+		problem.Detail = "1 validation error"
+	default:
+		problem.Detail = fmt.Sprintf("%d validation errors", count)
+	}
+
+	for _, err := range result.Errors() {
+		problem.Errors = append(problem.Errors, ProblemError{
+			Field:   err.Field(),
+			Code:    err.Code(),
+			Message: err.Message(),
+			Params:  err.Params(),
+			Pointer: err.Path().JSONPointer(),
+		})
+	}
+
+	return problem
+}
+
+// Writes the problem details of a validation result as application/problem+json
+func WriteProblemDetails(w http.ResponseWriter, status int, result ValidationResult) error {
+	return NewProblemDetails(status, result).Write(w)
+}
+
+// Writes the problem as application/problem+json, using its status as response code,
+// a zero status is written as 400
+func (p ProblemDetails) Write(w http.ResponseWriter) error {
+	if p.Status == 0 {
+		p.Status = defaultProblemStatus
+	}
+
+	w.Header().Set("Content-Type", ProblemDetailsContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,184 @@
+package govalid
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrDuplicateRule is returned when registering a rule with a name already in use
+var ErrDuplicateRule = errors.New("rule already registered")
+
+// ErrUnknownRule is returned when building a rule that is not registered
+var ErrUnknownRule = errors.New("unknown rule")
+
+// FieldLookup returns the value of another field of the value being validated,
+// by Go or json name, and whether the field exists
+type FieldLookup func(name string) (any, bool)
+
+// Params are the parameters of a rule reference, i.e. "1,10" for "between:1,10"
+type Params struct {
+	raw    string
+	lookup FieldLookup
+}
+
+// Creates the params of a rule, lookup can be nil if no other field is available
+func NewParams(raw string, lookup FieldLookup) Params {
+	return Params{raw: raw, lookup: lookup}
+}
+
+// Returns the parameters as written, i.e. for a regex that can contain commas
+func (p Params) Raw() string {
+	return p.raw
+}
+
+// Returns the comma-separated parameters, trimmed
+func (p Params) List() []string {
+	if strings.TrimSpace(p.raw) == "" {
+		return nil
+	}
+
+	list := strings.Split(p.raw, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+	return list
+}
+
+// Parses the parameters as a single int
+func (p Params) Int() (int, error) {
+	return strconv.Atoi(strings.TrimSpace(p.raw))
+}
+
+// Parses the parameters as a single float64
+func (p Params) Float() (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(p.raw), 64)
+}
+
+// Parses the comma-separated parameters as int values, n is the expected count
+func (p Params) Ints(n int) ([]int, error) {
+	return parseList(p.List(), n, strconv.Atoi)
+}
+
+// Parses the comma-separated parameters as float64 values, n is the expected count
+func (p Params) Floats(n int) ([]float64, error) {
+	return parseList(p.List(), n, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+func parseList[T any](list []string, n int, parse func(string) (T, error)) ([]T, error) {
+	if len(list) != n {
+		return nil, fmt.Errorf("expected %d parameters, got %d", n, len(list))
+	}
+
+	values := make([]T, 0, n)
+	for _, item := range list {
+		value, err := parse(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Returns the value of another field, i.e. for cross-field rules
+func (p Params) Lookup(field string) (any, bool) {
+	if p.lookup == nil {
+		return nil, false
+	}
+	return p.lookup(field)
+}
+
+// RuleFactory builds a ValidationRule from its parameters
+// It returns an error if the parameters are not valid
+type RuleFactory func(params Params) (ValidationRule, error)
+
+// Registry maps rule names to factories, so rules can be referenced
+// from struct tags, schema files or configuration
+type Registry struct {
+	mu    sync.RWMutex
+	rules map[string]RuleFactory
+}
+
+func NewRegistry() *Registry {
+	return &Registry{rules: map[string]RuleFactory{}}
+}
+
+// DefaultRegistry is used by ValidateStruct and the schema package,
+// the validators package registers the built-in rules in it
+var DefaultRegistry = NewRegistry()
+
+// Registers a rule under name, it returns ErrDuplicateRule if the name is already used
+func (r *Registry) Register(name string, factory RuleFactory) error {
+	if name == "" || strings.ContainsAny(name, ",=: ") {
+		return fmt.Errorf("invalid rule name %q", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.rules[name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateRule, name)
+	}
+	r.rules[name] = factory
+	return nil
+}
+
+// Same as Register, but panics on error
+func (r *Registry) MustRegister(name string, factory RuleFactory) {
+	if err := r.Register(name, factory); err != nil {
+		panic(err)
+	}
+}
+
+// Returns the factory of a rule and whether it is registered
+func (r *Registry) Lookup(name string) (RuleFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	factory, ok := r.rules[name]
+	return factory, ok
+}
+
+// Returns the names of the registered rules, sorted
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.rules))
+	for name := range r.rules {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Builds a rule from a reference like "required", "between:1,10" or "min_len=3"
+func (r *Registry) Rule(spec string, lookup FieldLookup) (ValidationRule, error) {
+	name, params := ParseRuleSpec(spec)
+
+	factory, ok := r.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownRule, name)
+	}
+
+	rule, err := factory(NewParams(params, lookup))
+	if err != nil {
+		return nil, fmt.Errorf("rule %q: invalid parameters %q: %w", name, params, err)
+	}
+	return rule, nil
+}
+
+// Splits a rule reference into name and parameters, at the first ':' or '='
+func ParseRuleSpec(spec string) (name, params string) {
+	spec = strings.TrimSpace(spec)
+	if i := strings.IndexAny(spec, ":="); i >= 0 {
+		return spec[:i], spec[i+1:]
+	}
+	return spec, ""
+}
@@ -0,0 +1,107 @@
+package govalid
+
+// SchemaField describes how to read a field from T and which rules apply to it
+type SchemaField[T any] struct {
+	name         string
+	accessor     func(T) any
+	rules        []ValidationRule
+	shortCircuit bool
+}
+
+// Schema is a reusable set of field validations for values of type T
+// It is immutable once created, so it can be shared and used concurrently
+//
+//	personSchema := govalid.NewSchema(
+//		govalid.Field("name", func(p Person) string { return p.Name },
+//			validators.NonEmptyRule(),
+//			validators.MinLengthRule(3),
+//		),
+//		govalid.Field("age", func(p Person) int { return p.Age },
+//			validators.MinRule(18),
+//		),
+//	)
+//
+//	res := personSchema.Validate(person)
+type Schema[T any] struct {
+	fields []SchemaField[T]
+}
+
+// Creates a schema from a list of fields
+func NewSchema[T any](fields ...SchemaField[T]) *Schema[T] {
+	return &Schema[T]{
+		fields: append([]SchemaField[T](nil), fields...),
+	}
+}
+
+// Defines a schema field, validating it will return all errors
+func Field[T any, V any](name string, accessor func(T) V, rules ...ValidationRule) SchemaField[T] {
+	return SchemaField[T]{
+		name:     name,
+		accessor: func(t T) any { return accessor(t) },
+		rules:    append([]ValidationRule(nil), rules...),
+	}
+}
+
+// Defines a schema field, validating it will return the first error
+func FieldShortCircuit[T any, V any](name string, accessor func(T) V, rules ...ValidationRule) SchemaField[T] {
+	field := Field(name, accessor, rules...)
+	field.shortCircuit = true
+	return field
+}
+
+// Returns the names of the schema fields, in definition order
+func (s *Schema[T]) FieldNames() []string {
+	names := make([]string, 0, len(s.fields))
+	for _, f := range s.fields {
+		names = append(names, f.name)
+	}
+	return names
+}
+
+// Runs all field validations on t and returns errors
+func (s *Schema[T]) Validate(t T) ValidationResult {
+	return s.validate(validateAllMode, t)
+}
+
+// Runs the field validations on t and stops at the first error, if any
+func (s *Schema[T]) ValidateShortCircuit(t T) ValidationResult {
+	return s.validate(failFastMode, t)
+}
+
+func (s *Schema[T]) validate(failFastMode bool, t T) ValidationResult {
+	result := NewValidationResult()
+
+	for _, f := range s.fields {
+		value := f.accessor(t)
+		for _, rule := range f.rules {
+			err := rule(f.name, value)()
+			if err == nil {
+				continue
+			}
+
+			result.addError(*err)
+			if failFastMode {
+				return result
+			}
+			if f.shortCircuit {
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// Binds the schema to t, returning validations usable with Compose and Validate
+func (s *Schema[T]) Bind(t T) []ValidationFunc {
+	funcs := make([]ValidationFunc, 0, len(s.fields))
+	for _, f := range s.fields {
+		group := Group(f.name, f.accessor(t), f.rules...)
+		if f.shortCircuit {
+			funcs = append(funcs, ComposeShortCircuit(group))
+		} else {
+			funcs = append(funcs, group...)
+		}
+	}
+	return funcs
+}
@@ -0,0 +1,245 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"gopkg.in/yaml.v3"
+)
+
+// Error reports a malformed schema file, with the position of the problem
+type Error struct {
+	File string
+	Line int
+	// 0 when unknown, YAML syntax errors only report the line
+	Column  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	file := e.File
+	if file == "" {
+		file = "schema"
+	}
+	if e.Column == 0 {
+		return fmt.Sprintf("%s:%d: %s", file, e.Line, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", file, e.Line, e.Column, e.Message)
+}
+
+func errorAt(node *yaml.Node, format string, args ...any) *Error {
+	return &Error{Line: node.Line, Column: node.Column, Message: fmt.Sprintf(format, args...)}
+}
+
+var yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): `)
+
+// Parses a schema from JSON or YAML, JSON being a subset of YAML
+// Rules referencing other fields, i.e. eq_field, must reference fields of the schema,
+// fields without rules can be declared with an empty list, i.e. "password: []"
+func Parse(data []byte) (*Schema, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, syntaxError(err)
+	}
+	if len(root.Content) == 0 {
+		return nil, &Error{Line: 1, Column: 1, Message: "empty schema"}
+	}
+
+	return parseDocument(root.Content[0])
+}
+
+// Reads and parses a schema from JSON or YAML
+func Load(r io.Reader) (*Schema, error) {
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return Parse(buf.Bytes())
+}
+
+// Reads and parses a schema file, errors report the file name
+func LoadFile(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := Parse(data)
+	if schemaErr, ok := err.(*Error); ok {
+		schemaErr.File = path
+	}
+	return s, err
+}
+
+// Takes the line from the YAML error, the column is not reported
+func syntaxError(err error) *Error {
+	match := yamlErrorLine.FindStringSubmatch(err.Error())
+	if match == nil {
+		return &Error{Line: 1, Message: err.Error()}
+	}
+
+	line, _ := strconv.Atoi(match[1])
+	return &Error{Line: line, Message: strings.TrimPrefix(err.Error(), match[0])}
+}
+
+func parseDocument(doc *yaml.Node) (*Schema, error) {
+	if doc.Kind != yaml.MappingNode {
+		return nil, errorAt(doc, "expected an object with a \"fields\" key")
+	}
+
+	var fieldsNode *yaml.Node
+	for i := 0; i < len(doc.Content); i += 2 {
+		key, value := doc.Content[i], doc.Content[i+1]
+		switch key.Value {
+		case "fields":
+			fieldsNode = value
+		default:
+			return nil, errorAt(key, "unknown key %q", key.Value)
+		}
+	}
+
+	if fieldsNode == nil {
+		return nil, errorAt(doc, "missing \"fields\" key")
+	}
+	if fieldsNode.Kind != yaml.MappingNode {
+		return nil, errorAt(fieldsNode, "\"fields\" must be an object of field names to rules")
+	}
+
+	s := &Schema{}
+	for i := 0; i < len(fieldsNode.Content); i += 2 {
+		key, value := fieldsNode.Content[i], fieldsNode.Content[i+1]
+		if key.Value == "" {
+			return nil, errorAt(key, "empty field name")
+		}
+
+		f, err := parseField(key.Value, value)
+		if err != nil {
+			return nil, err
+		}
+		s.fields = append(s.fields, f)
+	}
+
+	if err := s.checkParams(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Checks the parameters of every rule, once all fields are known, so references
+// to other fields can be checked too
+func (s *Schema) checkParams() error {
+	for _, f := range s.fields {
+		lookup := func(name string) (any, bool) {
+			return nil, s.declares(f.sibling(name))
+		}
+
+		for _, r := range f.rules {
+			if _, err := r.factory(govalid.NewParams(r.param, lookup)); err != nil {
+				return &Error{Line: r.line, Column: r.column, Message: fmt.Sprintf("rule %q: invalid parameter %q: %v", r.name, r.param, err)}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) declares(path govalid.Path) bool {
+	for _, f := range s.fields {
+		if slices.Equal(f.path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseField(name string, node *yaml.Node) (field, error) {
+	f := field{name: name, path: govalid.ParsePath(name)}
+
+	if node.Kind != yaml.SequenceNode {
+		return f, errorAt(node, "field %q: expected a list of rules", name)
+	}
+
+	for _, ruleNode := range node.Content {
+		r, err := parseRule(ruleNode)
+		if err != nil {
+			return f, err
+		}
+		f.rules = append(f.rules, r)
+	}
+
+	return f, nil
+}
+
+// Parses a rule written as a name, i.e. "required", or as an object with the
+// rule name as key and its parameter as value, plus an optional message, i.e.
+// {min_len: 3, message: "too short"}. List parameters are joined with commas
+func parseRule(node *yaml.Node) (rule, error) {
+	var r rule
+	var paramNode *yaml.Node
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		r.name = node.Value
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "message" {
+				if value.Kind != yaml.ScalarNode {
+					return r, errorAt(value, "message must be a string")
+				}
+				r.message = value.Value
+				continue
+			}
+			if r.name != "" {
+				return r, errorAt(key, "rule %q: only one rule per item is allowed, found %q", r.name, key.Value)
+			}
+			r.name, paramNode = key.Value, value
+		}
+		if r.name == "" {
+			return r, errorAt(node, "missing rule name")
+		}
+	default:
+		return r, errorAt(node, "expected a rule name or an object")
+	}
+
+	if paramNode != nil {
+		param, err := parseParam(r.name, paramNode)
+		if err != nil {
+			return r, err
+		}
+		r.param = param
+	}
+
+	factory, ok := govalid.DefaultRegistry.Lookup(r.name)
+	if !ok {
+		return r, errorAt(node, "unknown rule %q", r.name)
+	}
+	r.factory = factory
+	r.line, r.column = node.Line, node.Column
+
+	return r, nil
+}
+
+func parseParam(ruleName string, node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Value, nil
+	case yaml.SequenceNode:
+		values := make([]string, 0, len(node.Content))
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return "", errorAt(item, "rule %q: parameters must be scalar values", ruleName)
+			}
+			values = append(values, item.Value)
+		}
+		return strings.Join(values, ","), nil
+	default:
+		return "", errorAt(node, "rule %q: parameters must be a scalar or a list of scalars", ruleName)
+	}
+}
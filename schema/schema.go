@@ -0,0 +1,207 @@
+// Package schema loads declarative validation schemas from JSON or YAML files
+//
+//	fields:
+//	  name:
+//	    - required
+//	    - min_len: 3
+//	  email: [required, email]
+//	  age:
+//	    - min: 18
+//	      message: you are too young
+//	  address.city:
+//	    - max_len: 20
+//
+// Rule names are looked up in govalid.DefaultRegistry, like `validate` struct tags,
+// so the validators package must be imported to register the built-in rules
+package schema
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+)
+
+type rule struct {
+	name    string
+	param   string
+	message string
+	factory govalid.RuleFactory
+	// Position in the schema file
+	line, column int
+}
+
+type field struct {
+	name  string
+	path  govalid.Path
+	rules []rule
+}
+
+// Returns the path of another field, names are relative to the parent of the field
+func (f field) sibling(name string) govalid.Path {
+	return f.path[:len(f.path)-1].Append(govalid.ParsePath(name)...)
+}
+
+// Code of the errors reported when a rule can not be built while validating,
+// i.e. a custom rule rejecting the value of another field
+const CodeInvalidRule = "invalid_rule"
+
+// Schema is a reusable validator loaded from a schema file
+// It is immutable once loaded, so it can be shared and used concurrently
+type Schema struct {
+	fields []field
+}
+
+// Returns the names of the schema fields, in file order
+func (s *Schema) Fields() []string {
+	names := make([]string, 0, len(s.fields))
+	for _, f := range s.fields {
+		names = append(names, f.name)
+	}
+	return names
+}
+
+// Rule is a rule reference of a schema field, as written in the file
+type Rule struct {
+	Name    string
+	Param   string
+	Message string
+}
+
+// Returns the rules of a field, in file order, nil if the field does not exist
+func (s *Schema) Rules(field string) []Rule {
+	for _, f := range s.fields {
+		if f.name != field {
+			continue
+		}
+
+		rules := make([]Rule, 0, len(f.rules))
+		for _, r := range f.rules {
+			rules = append(rules, Rule{Name: r.name, Param: r.param, Message: r.message})
+		}
+		return rules
+	}
+	return nil
+}
+
+// Validates a map[string]any, a struct or a pointer to a struct
+// Dotted field names like "address.city" are resolved through nested maps and structs,
+// struct fields are matched by json or Go name. Fields missing from v are nil values
+func (s *Schema) Validate(v any) govalid.ValidationResult {
+	var funcs []govalid.ValidationFunc
+
+	for _, f := range s.fields {
+		value, _ := resolve(v, f.path)
+		parent, _ := resolve(v, f.path[:len(f.path)-1])
+		// References to other fields were checked when loading the schema
+		lookup := func(name string) (any, bool) {
+			other, _ := resolve(parent, govalid.ParsePath(name))
+			return other, true
+		}
+
+		for _, r := range f.rules {
+			// A missing value can not be checked, only its presence can be validated
+			if value == nil && r.name != "required" && !strings.HasPrefix(r.name, "required_") {
+				continue
+			}
+
+			validationRule, err := r.factory(govalid.NewParams(r.param, lookup))
+			if err != nil {
+				funcs = append(funcs, invalidRule(f, r, err))
+				continue
+			}
+
+			funcs = append(funcs, withDetails(f.path, r.message, validationRule(f.name, value)))
+		}
+	}
+
+	return govalid.Validate(funcs)
+}
+
+func invalidRule(f field, r rule, err error) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		return internal.NewValidationErrorf("", "rule %s: %v", r.name, err).
+			WithPath(f.path).WithCode(CodeInvalidRule).WithParam("rule", r.name)
+	}
+}
+
+// Sets the exact field path, as names can contain dots, and the custom message if any
+func withDetails(path govalid.Path, message string, validation govalid.ValidationFunc) govalid.ValidationFunc {
+	return internal.MapError(validation, func(e *internal.ValidationError) {
+		e.WithPath(path)
+		if message != "" {
+			e.WithCustomMessage(message)
+		}
+	})
+}
+
+// Returns the value at path inside nested maps and structs, nil if it does not exist
+// The boolean is false only when a struct field does not exist, missing map keys are nil values
+func resolve(v any, path govalid.Path) (any, bool) {
+	for _, segment := range path {
+		if v == nil {
+			return nil, true
+		}
+
+		rv := reflect.ValueOf(v)
+		for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+			if rv.IsNil() {
+				return nil, true
+			}
+			rv = rv.Elem()
+		}
+
+		switch rv.Kind() {
+		case reflect.Map:
+			if rv.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+			item := rv.MapIndex(reflect.ValueOf(segment.String()).Convert(rv.Type().Key()))
+			if !item.IsValid() {
+				return nil, true
+			}
+			v = item.Interface()
+		case reflect.Struct:
+			item, ok := structField(rv, segment.String())
+			if !ok {
+				return nil, false
+			}
+			v = item
+		default:
+			return nil, false
+		}
+	}
+
+	return deref(v), true
+}
+
+func structField(rv reflect.Value, name string) (any, bool) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Name == name || jsonName == name {
+			return rv.Field(i).Interface(), true
+		}
+	}
+	return nil, false
+}
+
+func deref(v any) any {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil
+	}
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	return rv.Interface()
+}
@@ -0,0 +1,195 @@
+package govalid
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Palma99/govalid/internal"
+)
+
+const structTagName = "validate"
+
+// Validates a struct (or a pointer to a struct) using its `validate` tags
+// Nested structs, pointers and slices of structs are walked recursively, a pointer to a value
+// already walked is not walked again, so cyclic values terminate
+// It panics if v is not a struct or if a tag references an unknown rule
+//
+// Tags reference rules of DefaultRegistry. The built-in rules are registered by the validators
+// package, which imports this one, so it must be imported for its side effects at least once:
+//
+//	import _ "github.com/Palma99/govalid/validators"
+//
+// i.e.
+//
+//	type Person struct {
+//		Name  string `validate:"required,min_len=3"`
+//		Email string `validate:"required,email"`
+//	}
+//
+//	govalid.ValidateStruct(person)
+func ValidateStruct(v any) ValidationResult {
+	// Pointers already walked are skipped, so cyclic values are validated once
+	visited := map[visitKey]bool{}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			panic("ValidateStruct: nil pointer")
+		}
+		visited[visitKey{rv.Pointer(), rv.Type()}] = true
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ValidateStruct: unsupported type %T", v))
+	}
+
+	return Validate(structValidations(nil, rv, visited))
+}
+
+func structValidations(prefix Path, rv reflect.Value, visited map[visitKey]bool) []ValidationFunc {
+	var funcs []ValidationFunc
+
+	lookup := fieldLookup(rv)
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get(structTagName)
+		if tag == "-" {
+			continue
+		}
+
+		path := prefix.Append(FieldSegment(structFieldName(field)))
+		value := rv.Field(i)
+
+		if tag != "" {
+			funcs = append(funcs, fieldValidations(path, value, tag, lookup)...)
+		}
+		funcs = append(funcs, nestedValidations(path, value, visited)...)
+	}
+
+	return funcs
+}
+
+func fieldValidations(path Path, value reflect.Value, tag string, lookup FieldLookup) []ValidationFunc {
+	var funcs []ValidationFunc
+	name := path.String()
+
+	isNil := value.Kind() == reflect.Pointer && value.IsNil()
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	for _, entry := range SplitTag(tag) {
+		ruleName, _ := ParseRuleSpec(entry)
+
+		rule, err := DefaultRegistry.Rule(entry, lookup)
+		if err != nil {
+			if errors.Is(err, ErrUnknownRule) && len(DefaultRegistry.Names()) == 0 {
+				panic(fmt.Sprintf("ValidateStruct: field %s: %v, no rules are registered, import the validators package", name, err))
+			}
+			panic(fmt.Sprintf("ValidateStruct: field %s: %v", name, err))
+		}
+
+		// A nil pointer has no value to check, only its presence can be validated
+		if isNil {
+			if ruleName == "required" || strings.HasPrefix(ruleName, "required_") {
+				funcs = append(funcs, withPath(path, rule(name, nil)))
+			}
+			continue
+		}
+
+		funcs = append(funcs, withPath(path, rule(name, value.Interface())))
+	}
+
+	return funcs
+}
+
+// Splits a `validate` tag into rule references. Parameters can contain commas, i.e. "between=1,10",
+// so a part that is not a registered rule belongs to the parameters of the previous one
+func SplitTag(tag string) []string {
+	var entries []string
+	for _, part := range strings.Split(tag, ",") {
+		name, _ := ParseRuleSpec(part)
+		if len(entries) > 0 && strings.ContainsAny(entries[len(entries)-1], ":=") {
+			if _, ok := DefaultRegistry.Lookup(name); !ok {
+				entries[len(entries)-1] += "," + part
+				continue
+			}
+		}
+		if name != "" {
+			entries = append(entries, strings.TrimSpace(part))
+		}
+	}
+	return entries
+}
+
+func nestedValidations(path Path, value reflect.Value, visited map[visitKey]bool) []ValidationFunc {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		key := visitKey{value.Pointer(), value.Type()}
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		return structValidations(path, value, visited)
+	case reflect.Slice, reflect.Array:
+		var funcs []ValidationFunc
+		for i := 0; i < value.Len(); i++ {
+			funcs = append(funcs, nestedValidations(path.Append(IndexSegment(i)), value.Index(i), visited)...)
+		}
+		return funcs
+	}
+
+	return nil
+}
+
+func fieldLookup(rv reflect.Value) FieldLookup {
+	return func(name string) (any, bool) {
+		rt := rv.Type()
+		for i := 0; i < rt.NumField(); i++ {
+			field := rt.Field(i)
+			if !field.IsExported() || (field.Name != name && structFieldName(field) != name) {
+				continue
+			}
+
+			value := rv.Field(i)
+			for value.Kind() == reflect.Pointer {
+				if value.IsNil() {
+					return nil, true
+				}
+				value = value.Elem()
+			}
+			return value.Interface(), true
+		}
+		return nil, false
+	}
+}
+
+// Uses the json name of the field if present, the Go field name otherwise
+func structFieldName(field reflect.StructField) string {
+	if jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ","); jsonName != "" && jsonName != "-" {
+		return jsonName
+	}
+
+	return field.Name
+}
+
+// Field names can contain dots, so the path is set on the error instead of being parsed from the name
+func withPath(path Path, validation ValidationFunc) ValidationFunc {
+	return internal.MapError(validation, func(e *internal.ValidationError) { e.WithPath(path) })
+}
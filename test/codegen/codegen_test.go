@@ -0,0 +1,162 @@
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Palma99/govalid/internal/codegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const source = `package models
+
+type Age int
+
+type Person struct {
+	Name  string ` + "`json:\"name\" validate:\"required,min_len=3\"`" + `
+	Email string ` + "`validate:\"email\"`" + `
+	Age   Age    ` + "`json:\"age\" validate:\"min=18\"`" + `
+	Other string
+}
+
+type Untagged struct {
+	Name string
+}
+`
+
+func writePackage(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("should generate a Validate method for tagged structs", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{"models.go": source})
+
+		src, pkgName, err := codegen.Generate(dir, nil)
+		require.NoError(t, err)
+
+		expected := `// Code generated by govalid-gen. DO NOT EDIT.
+
+package models
+
+import (
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+)
+
+func (x *Person) Validate() govalid.ValidationResult {
+	return govalid.Validate(x.govalidValidations())
+}
+
+func (x *Person) ValidatesNested() {}
+
+func (x *Person) govalidValidations() []govalid.ValidationFunc {
+	var funcs []govalid.ValidationFunc
+	funcs = append(funcs,
+		validators.NonEmpty("name", x.Name),
+		validators.MinLength("name", x.Name, 3),
+		validators.IsEmail("Email", x.Email),
+		validators.Min[Age]("age", x.Age, 18),
+	)
+	return funcs
+}
+`
+		assert.Equal(t, "models", pkgName)
+		assert.Equal(t, expected, string(src))
+	})
+
+	t.Run("should be deterministic", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{
+			"b.go": "package models\n\ntype B struct {\n\tName string `validate:\"required\"`\n}\n",
+			"a.go": "package models\n\ntype A struct {\n\tName string `validate:\"required\"`\n}\n",
+		})
+
+		first, _, err := codegen.Generate(dir, nil)
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			src, _, err := codegen.Generate(dir, nil)
+			require.NoError(t, err)
+			assert.Equal(t, first, src)
+		}
+		assert.Less(t, strings.Index(string(first), "*A)"), strings.Index(string(first), "*B)"))
+	})
+
+	t.Run("should skip previously generated files", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{"models.go": source})
+
+		src, _, err := codegen.Generate(dir, nil)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "models_validate_gen.go"), src, 0o644))
+
+		again, _, err := codegen.Generate(dir, nil)
+		require.NoError(t, err)
+		assert.Equal(t, src, again)
+	})
+
+	t.Run("should fail on unknown types", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{"models.go": source})
+
+		_, _, err := codegen.Generate(dir, []string{"Untagged"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail on unsupported rules", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{
+			"models.go": "package models\n\ntype A struct {\n\tName string `validate:\"custom\"`\n}\n",
+		})
+
+		_, _, err := codegen.Generate(dir, nil)
+		assert.ErrorContains(t, err, `rule "custom" is not supported`)
+	})
+
+	t.Run("should generate the nested types of the listed ones", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{
+			"models.go": "package models\n\ntype A struct {\n\tB []*B\n}\n\ntype B struct {\n\tName string `validate:\"required\"`\n}\n\ntype C struct {\n\tName string `validate:\"required\"`\n}\n",
+		})
+
+		src, _, err := codegen.Generate(dir, []string{"A"})
+		require.NoError(t, err)
+		assert.Contains(t, string(src), "func (x *B) Validate()")
+		assert.NotContains(t, string(src), "func (x *C) Validate()")
+	})
+
+	t.Run("should fail on unsupported field types", func(t *testing.T) {
+		for _, models := range []string{
+			"package models\n\ntype A struct {\n\tAge int `validate:\"email\"`\n}\n",
+			"package models\n\ntype A struct {\n\tName **string `validate:\"required\"`\n}\n",
+			"package models\n\ntype A struct {\n\tB *[]B\n}\n\ntype B struct {\n\tName string `validate:\"required\"`\n}\n",
+			"package models\n\ntype A struct {\n\tB struct {\n\t\tName string `validate:\"required\"`\n\t}\n}\n",
+		} {
+			dir := writePackage(t, map[string]string{"models.go": models})
+
+			_, _, err := codegen.Generate(dir, nil)
+			assert.ErrorContains(t, err, "models.go:", models)
+		}
+	})
+
+	t.Run("should fail on negative bounds for unsigned fields", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{
+			"models.go": "package models\n\ntype Count uint\n\ntype A struct {\n\tCount Count `validate:\"min=-1\"`\n}\n",
+		})
+
+		_, _, err := codegen.Generate(dir, nil)
+		assert.ErrorContains(t, err, `min parameter "-1" is negative for the unsigned type Count`)
+	})
+
+	t.Run("should fail on non integer bounds for integer fields", func(t *testing.T) {
+		dir := writePackage(t, map[string]string{
+			"models.go": "package models\n\ntype A struct {\n\tAge int `validate:\"min=1.5\"`\n}\n",
+		})
+
+		_, _, err := codegen.Generate(dir, nil)
+		assert.Error(t, err)
+	})
+}
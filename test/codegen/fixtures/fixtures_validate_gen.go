@@ -0,0 +1,118 @@
+// Code generated by govalid-gen. DO NOT EDIT.
+
+package fixtures
+
+import (
+	"strconv"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+)
+
+func (x *Address) Validate() govalid.ValidationResult {
+	return govalid.Validate(x.govalidValidations())
+}
+
+func (x *Address) ValidatesNested() {}
+
+func (x *Address) govalidValidations() []govalid.ValidationFunc {
+	var funcs []govalid.ValidationFunc
+	funcs = append(funcs,
+		validators.NonEmpty("street", x.Street),
+		validators.NonEmpty("city", x.City),
+		validators.MaxLength("city", x.City, 10),
+	)
+	return funcs
+}
+
+func (x *LineItem) Validate() govalid.ValidationResult {
+	return govalid.Validate(x.govalidValidations())
+}
+
+func (x *LineItem) ValidatesNested() {}
+
+func (x *LineItem) govalidValidations() []govalid.ValidationFunc {
+	var funcs []govalid.ValidationFunc
+	funcs = append(funcs,
+		validators.NonEmpty("sku", x.Sku),
+		validators.MatchesRegex("sku", x.Sku, "^[A-Z]{3}$"),
+		validators.Min[uint]("quantity", x.Quantity, 1),
+		validators.Max[uint]("quantity", x.Quantity, 10),
+	)
+	return funcs
+}
+
+func (x *Audit) Validate() govalid.ValidationResult {
+	return govalid.Validate(x.govalidValidations())
+}
+
+func (x *Audit) ValidatesNested() {}
+
+func (x *Audit) govalidValidations() []govalid.ValidationFunc {
+	var funcs []govalid.ValidationFunc
+	funcs = append(funcs,
+		validators.NonEmpty("CreatedBy", x.CreatedBy),
+	)
+	return funcs
+}
+
+func (x *Order) Validate() govalid.ValidationResult {
+	return govalid.Validate(x.govalidValidations())
+}
+
+func (x *Order) ValidatesNested() {}
+
+func (x *Order) govalidValidations() []govalid.ValidationFunc {
+	var funcs []govalid.ValidationFunc
+	funcs = append(funcs, govalid.Nest("Audit", x.Audit.govalidValidations())...)
+	funcs = append(funcs,
+		validators.NonEmpty("name", x.Name),
+		validators.MinLength("name", x.Name, 3),
+		validators.IsEmail("email", string(x.Email)),
+		validators.MaxLength("coupon", x.Coupon, 5),
+		validators.MatchesRegex("coupon", x.Coupon, "^[A-Z]{0,3}$"),
+	)
+	if x.Nickname == nil {
+		funcs = append(funcs,
+			validators.NonEmpty("nickname", nil),
+		)
+	} else {
+		funcs = append(funcs,
+			validators.NonEmpty("nickname", *x.Nickname),
+			validators.MinLength("nickname", *x.Nickname, 3),
+		)
+	}
+	if x.Contact != nil {
+		funcs = append(funcs,
+			validators.IsEmail("contact", *x.Contact),
+		)
+	}
+	if x.Age != nil {
+		funcs = append(funcs,
+			validators.Min[Age]("age", *x.Age, 18),
+		)
+	}
+	funcs = append(funcs, govalid.Nest("address", x.Address.govalidValidations())...)
+	if x.Billing != nil {
+		funcs = append(funcs, govalid.Nest("billing", x.Billing.govalidValidations())...)
+	}
+	if x.Shipping == nil {
+		funcs = append(funcs,
+			validators.NonEmpty("shipping", nil),
+		)
+	} else {
+		funcs = append(funcs,
+			validators.NonEmpty("shipping", *x.Shipping),
+		)
+		funcs = append(funcs, govalid.Nest("shipping", x.Shipping.govalidValidations())...)
+	}
+	for i := range x.Items {
+		funcs = append(funcs, govalid.Nest("items["+strconv.Itoa(i)+"]", x.Items[i].govalidValidations())...)
+	}
+	for i := range x.Extras {
+		if x.Extras[i] != nil {
+			funcs = append(funcs, govalid.Nest("extras["+strconv.Itoa(i)+"]", x.Extras[i].govalidValidations())...)
+		}
+	}
+	return funcs
+}
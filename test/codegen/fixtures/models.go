@@ -0,0 +1,43 @@
+// Package fixtures holds the structs used to compare the code generated by govalid-gen
+// with ValidateStruct, regenerate fixtures_validate_gen.go with
+//
+//	go run ./cmd/govalid-gen -dir test/codegen/fixtures
+package fixtures
+
+import "time"
+
+type Age int
+
+type Email string
+
+type Address struct {
+	Street string `json:"street" validate:"required"`
+	City   string `json:"city" validate:"required,max_len=10"`
+}
+
+type LineItem struct {
+	Sku      string `json:"sku" validate:"required,matches=^[A-Z]{3}$"`
+	Quantity uint   `json:"quantity" validate:"min=1,max=10"`
+}
+
+type Audit struct {
+	CreatedBy string `validate:"required"`
+}
+
+type Order struct {
+	Audit
+	Name     string      `json:"name" validate:"required,min_len=3"`
+	Email    Email       `json:"email" validate:"email"`
+	Coupon   string      `json:"coupon" validate:"max_len:5,matches=^[A-Z]{0,3}$"`
+	Nickname *string     `json:"nickname" validate:"required,min_len=3"`
+	Contact  *string     `json:"contact" validate:"email"`
+	Age      *Age        `json:"age" validate:"min=18"`
+	Address  Address     `json:"address"`
+	Billing  *Address    `json:"billing"`
+	Shipping *Address    `json:"shipping" validate:"required"`
+	Items    []LineItem  `json:"items"`
+	Extras   []*LineItem `json:"extras"`
+	Ignored  Address     `validate:"-"`
+	Placed   time.Time   `json:"placed"`
+	note     string
+}
@@ -0,0 +1,103 @@
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal/codegen"
+	"github.com/Palma99/govalid/test/codegen/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedCode(t *testing.T) {
+	t.Run("should match the generated fixtures", func(t *testing.T) {
+		src, _, err := codegen.Generate("fixtures", nil)
+		require.NoError(t, err)
+
+		golden, err := os.ReadFile(filepath.Join("fixtures", "fixtures_validate_gen.go"))
+		require.NoError(t, err)
+		assert.Equal(t, string(golden), string(src), "regenerate with go run ./cmd/govalid-gen -dir test/codegen/fixtures")
+	})
+
+	nickname, short, contact, invalidContact := "Mario", "Al", "mario@example.com", "mario"
+	adult, minor := fixtures.Age(30), fixtures.Age(16)
+
+	valid := func() fixtures.Order {
+		return fixtures.Order{
+			Audit:    fixtures.Audit{CreatedBy: "admin"},
+			Name:     "Mario",
+			Email:    "mario@example.com",
+			Nickname: &nickname,
+			Contact:  &contact,
+			Age:      &adult,
+			Address:  fixtures.Address{Street: "Via Roma", City: "Milano"},
+			Shipping: &fixtures.Address{Street: "Via Roma", City: "Milano"},
+			Items:    []fixtures.LineItem{{Sku: "ABC", Quantity: 1}},
+			Extras:   []*fixtures.LineItem{nil, {Sku: "DEF", Quantity: 2}},
+		}
+	}
+
+	tests := map[string]func(o *fixtures.Order){
+		"valid": func(o *fixtures.Order) {},
+		"empty": func(o *fixtures.Order) { *o = fixtures.Order{} },
+		"invalid values": func(o *fixtures.Order) {
+			o.Name = "Al"
+			o.Email = "mario"
+			o.Coupon = "abcdef"
+			o.Audit.CreatedBy = ""
+		},
+		"nil pointers": func(o *fixtures.Order) {
+			o.Nickname, o.Contact, o.Age, o.Shipping = nil, nil, nil, nil
+		},
+		"invalid pointers": func(o *fixtures.Order) {
+			o.Nickname, o.Contact, o.Age = &short, &invalidContact, &minor
+		},
+		"invalid nested structs": func(o *fixtures.Order) {
+			o.Address.City = "Reggio Calabria"
+			o.Billing = &fixtures.Address{}
+			o.Shipping = &fixtures.Address{Street: "Via Roma"}
+			o.Ignored = fixtures.Address{}
+		},
+		"invalid slices": func(o *fixtures.Order) {
+			o.Items = append(o.Items, fixtures.LineItem{Sku: "abc", Quantity: 11})
+			o.Extras = append(o.Extras, &fixtures.LineItem{})
+		},
+	}
+
+	for name, mutate := range tests {
+		t.Run("should match ValidateStruct with "+name, func(t *testing.T) {
+			o := valid()
+			mutate(&o)
+
+			assert.Equal(t, summary(govalid.ValidateStruct(o)), summary(o.Validate()))
+		})
+	}
+
+	t.Run("should report nested errors once through govalid.Validate", func(t *testing.T) {
+		o := valid()
+		o.Address = fixtures.Address{}
+		o.Items = []fixtures.LineItem{{}}
+
+		expected := []string{
+			"/address/street non_empty: must not be empty",
+			"/address/city non_empty: must not be empty",
+			"/items/0/sku non_empty: must not be empty",
+			"/items/0/sku matches_regex: must match pattern ^[A-Z]{3}$",
+			"/items/0/quantity min: must be at least 1",
+		}
+		assert.Equal(t, expected, summary(o.Validate()))
+		assert.Equal(t, expected, summary(govalid.Validate(&o)))
+	})
+}
+
+// Values and params can differ in type, i.e. min is compared as float64 by the struct tag rule
+func summary(result govalid.ValidationResult) []string {
+	var errors []string
+	for _, err := range result.Errors() {
+		errors = append(errors, err.Path().JSONPointer()+" "+err.Code()+": "+err.Message())
+	}
+	return errors
+}
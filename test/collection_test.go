@@ -0,0 +1,116 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/Palma99/govalid/validators/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEach(t *testing.T) {
+	t.Run("should validate every element and report its index", func(t *testing.T) {
+		res := govalid.Validate(
+			govalid.Each("tags", []string{"go", "", "a very long tag"},
+				validators.NonEmptyRule(),
+				validators.MaxLengthRule(5),
+			),
+		)
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.Equal(t, "tags[1]", res.Errors()[0].Field())
+		assert.Equal(t, validators.CodeNonEmpty, res.Errors()[0].Code())
+		assert.Equal(t, "/tags/2", res.Errors()[1].Path().JSONPointer())
+	})
+
+	t.Run("should return the first error", func(t *testing.T) {
+		validation := govalid.EachShortCircuit("tags", []string{"go", "", ""},
+			validators.NonEmptyRule(),
+		)
+
+		err := validation()
+		assert.NotNil(t, err)
+		assert.Equal(t, "tags[1]", err.Field())
+	})
+
+	t.Run("should return no errors for an empty slice", func(t *testing.T) {
+		res := govalid.Validate(govalid.Each[string]("tags", nil, validators.NonEmptyRule()))
+		assert.True(t, res.IsValid())
+	})
+
+	t.Run("should support nested field names", func(t *testing.T) {
+		res := govalid.Validate(govalid.Each("order.items", []int{1, 20}, validators.MaxRule(10)))
+		assert.Equal(t, "order.items[1]", res.FirstError().Field())
+	})
+
+	t.Run("should support typed rules", func(t *testing.T) {
+		res := govalid.Validate(govalid.EachOf("scores", []int{5, 11, 12}, typed.Max(10)))
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.Equal(t, "scores[1]", res.FirstError().Field())
+	})
+}
+
+func TestEachKey(t *testing.T) {
+	labels := map[string]string{
+		"env":   "",
+		"":      "empty",
+		"owner": "mario",
+	}
+
+	t.Run("should validate every key in sorted order", func(t *testing.T) {
+		res := govalid.Validate(govalid.EachKey("labels", map[string]int{"b_": 1, "a_": 2, "ok": 3},
+			validators.MatchesRegexRule(`^[a-z]+$`),
+		))
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.Equal(t, "labels[a_]", res.Errors()[0].Field())
+		assert.Equal(t, "a_", res.Errors()[0].Value())
+		assert.Equal(t, "labels[b_]", res.Errors()[1].Field())
+	})
+
+	t.Run("should return the first error", func(t *testing.T) {
+		err := govalid.EachKeyShortCircuit("labels", labels, validators.NonEmptyRule())()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "/labels/", err.Path().JSONPointer())
+	})
+
+	t.Run("should sort numeric keys by value", func(t *testing.T) {
+		res := govalid.Validate(govalid.EachKey("codes", map[int]bool{10: true, 2: true, 30: true},
+			validators.MaxRule(5),
+		))
+
+		assert.Equal(t, `codes[\10]`, res.Errors()[0].Field())
+		assert.Equal(t, `codes[\30]`, res.Errors()[1].Field())
+	})
+}
+
+func TestEachValue(t *testing.T) {
+	labels := map[string]string{
+		"owner": "",
+		"env":   "",
+		"team":  "core",
+	}
+
+	t.Run("should validate every value and report its key", func(t *testing.T) {
+		res := govalid.Validate(govalid.EachValue("labels", labels, validators.NonEmptyRule()))
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.Equal(t, "labels[env]", res.Errors()[0].Field())
+		assert.Equal(t, "labels[owner]", res.Errors()[1].Field())
+	})
+
+	t.Run("should return the first error", func(t *testing.T) {
+		err := govalid.EachValueShortCircuit("labels", labels, validators.NonEmptyRule())()
+		assert.Equal(t, "labels[env]", err.Field())
+	})
+
+	t.Run("should work with ValidateShortCircuit", func(t *testing.T) {
+		res := govalid.ValidateShortCircuit(
+			govalid.EachValue("labels", labels, validators.NonEmptyRule()),
+		)
+		assert.Equal(t, 1, res.ErrorCount())
+	})
+}
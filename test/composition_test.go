@@ -6,6 +6,7 @@ import (
 	"github.com/Palma99/govalid"
 	"github.com/Palma99/govalid/internal"
 	"github.com/Palma99/govalid/validators"
+	"github.com/Palma99/govalid/validators/typed"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -70,3 +71,46 @@ func TestGroup(t *testing.T) {
 		assert.Equal(t, "must be at least 100 characters", grouped().Message())
 	})
 }
+
+func TestGroupOf(t *testing.T) {
+	t.Run("should return all errors", func(t *testing.T) {
+		res := govalid.Validate(
+			govalid.GroupOf("name", "",
+				typed.NonEmpty(),
+				typed.MinLength(3),
+			),
+			govalid.GroupOf("age", 3,
+				typed.Min(18),
+			),
+		)
+
+		assert.Equal(t, 3, res.ErrorCount())
+	})
+
+	t.Run("should return the first error", func(t *testing.T) {
+		grouped := govalid.GroupOfShortCircuit("name", "",
+			typed.NonEmpty(),
+			typed.MinLength(3),
+		)
+
+		assert.Equal(t, "must not be empty", grouped().Message())
+	})
+}
+
+func TestAnyRule(t *testing.T) {
+	rule := govalid.AnyRule(typed.MinLength(3))
+
+	t.Run("should validate values of the rule type", func(t *testing.T) {
+		assert.Nil(t, rule("name", "Mario")())
+		assert.NotNil(t, rule("name", "Al")())
+	})
+
+	t.Run("should return an error instead of panicking on other types", func(t *testing.T) {
+		err := rule("name", 42)()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, govalid.CodeUnsupportedType, err.Code())
+		assert.Equal(t, "unsupported type int, expected string", err.Message())
+		assert.Equal(t, 42, err.Value())
+	})
+}
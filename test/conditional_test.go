@@ -0,0 +1,92 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhen(t *testing.T) {
+	isItalian := func(country string) func() bool {
+		return func() bool { return country == "IT" }
+	}
+
+	t.Run("should run the validations when the predicate is true", func(t *testing.T) {
+		res := govalid.Validate(
+			govalid.When(isItalian("IT"),
+				validators.NonEmpty("vat_number", ""),
+				govalid.Group("fiscal_code", "", validators.NonEmptyRule()),
+			),
+		)
+
+		assert.Equal(t, 2, res.ErrorCount())
+	})
+
+	t.Run("should skip the validations when the predicate is false", func(t *testing.T) {
+		res := govalid.Validate(
+			govalid.When(isItalian("DE"), validators.NonEmpty("vat_number", "")),
+		)
+
+		assert.True(t, res.IsValid())
+	})
+
+	t.Run("should evaluate the predicate when validating", func(t *testing.T) {
+		country := "DE"
+		validations := govalid.When(func() bool { return country == "IT" },
+			validators.NonEmpty("vat_number", ""),
+		)
+
+		assert.True(t, govalid.Validate(validations).IsValid())
+		country = "IT"
+		assert.False(t, govalid.Validate(validations).IsValid())
+	})
+
+	t.Run("should plug into Compose and ValidateShortCircuit", func(t *testing.T) {
+		res := govalid.ValidateShortCircuit(
+			govalid.Compose(
+				govalid.When(isItalian("DE"), validators.NonEmpty("vat_number", "")),
+				govalid.Unless(isItalian("IT"), validators.NonEmpty("tax_id", "")),
+				validators.NonEmpty("name", ""),
+			),
+		)
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "name", res.FirstError().Field())
+	})
+
+	t.Run("should panic on unsupported types", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.When(isItalian("IT"), 1)
+		})
+	})
+}
+
+func TestUnless(t *testing.T) {
+	res := govalid.Validate(
+		govalid.Unless(func() bool { return true }, validators.NonEmpty("a", "")),
+		govalid.Unless(func() bool { return false }, validators.NonEmpty("b", "")),
+	)
+
+	assert.Equal(t, 1, res.ErrorCount())
+	assert.Equal(t, "b", res.FirstError().Field())
+}
+
+func TestWhenRule(t *testing.T) {
+	yes := func() bool { return true }
+	no := func() bool { return false }
+
+	res := govalid.Validate(
+		govalid.Group("vat_number", "123",
+			govalid.WhenRule(yes, validators.MinLengthRule(11)),
+			govalid.WhenRule(no, validators.MaxLengthRule(1)),
+			govalid.UnlessRule(no, validators.MatchesRegexRule(`^IT`)),
+			govalid.UnlessRule(yes, validators.IsEmailRule()),
+		),
+	)
+
+	assert.Equal(t, 2, res.ErrorCount())
+	assert.Equal(t, validators.CodeMinLength, res.Errors()[0].Code())
+	assert.Equal(t, validators.CodeMatchesRegex, res.Errors()[1].Code())
+}
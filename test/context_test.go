@@ -0,0 +1,155 @@
+package govalid_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCtxValidatorSpy(counter *int, testErr *internal.ValidationError) govalid.ValidationFuncCtx {
+	return func(ctx context.Context) *internal.ValidationError {
+		*counter += 1
+		return testErr
+	}
+}
+
+func TestValidateCtx(t *testing.T) {
+	t.Run("should run all validations and return all errors", func(t *testing.T) {
+		callCount := 0
+
+		res, err := govalid.ValidateCtx(context.Background(),
+			createCtxValidatorSpy(&callCount, internal.NewValidationError("field1", "test error1")),
+			createCtxValidatorSpy(&callCount, nil),
+			createCtxValidatorSpy(&callCount, internal.NewValidationError("field2", "test error2")),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, callCount)
+		assert.Equal(t, 2, res.ErrorCount())
+	})
+
+	t.Run("should accept ValidationFunc values", func(t *testing.T) {
+		res, err := govalid.ValidateCtx(context.Background(),
+			validators.NonEmpty("name", ""),
+			govalid.Group("email", "",
+				validators.NonEmptyRule(),
+				validators.IsEmailRule(),
+			),
+			[]govalid.ValidationFuncCtx{
+				govalid.WithContext(validators.NonEmpty("surname", "")),
+			},
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, res.ErrorCount())
+	})
+
+	t.Run("should pass the context to the validations", func(t *testing.T) {
+		type key struct{}
+		ctx := context.WithValue(context.Background(), key{}, "value")
+
+		res, err := govalid.ValidateCtx(ctx,
+			govalid.ValidationFuncCtx(func(ctx context.Context) *internal.ValidationError {
+				if ctx.Value(key{}) != "value" {
+					return internal.NewValidationError("ctx", "missing value")
+				}
+				return nil
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.True(t, res.IsValid())
+	})
+
+	t.Run("should stop when the context is cancelled and report skipped validations", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		callCount := 0
+
+		res, err := govalid.ValidateCtx(ctx,
+			createCtxValidatorSpy(&callCount, internal.NewValidationError("field1", "test error1")),
+			govalid.ValidationFuncCtx(func(ctx context.Context) *internal.ValidationError {
+				callCount++
+				cancel()
+				return nil
+			}),
+			createCtxValidatorSpy(&callCount, nil),
+			createCtxValidatorSpy(&callCount, nil),
+		)
+
+		assert.Equal(t, 2, callCount)
+		assert.Equal(t, 1, res.ErrorCount())
+
+		var interrupted *govalid.InterruptedError
+		assert.True(t, errors.As(err, &interrupted))
+		assert.Equal(t, []int{2, 3}, interrupted.Skipped)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("should report skipped arguments, not flattened validations", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		callCount := 0
+
+		_, err := govalid.ValidateCtx(ctx,
+			[]govalid.ValidationFunc{validators.NonEmpty("name", "Mario"), validators.NonEmpty("surname", "Rossi")},
+			[]govalid.ValidationFuncCtx{
+				createCtxValidatorSpy(&callCount, nil),
+				func(ctx context.Context) *internal.ValidationError {
+					cancel()
+					return nil
+				},
+				createCtxValidatorSpy(&callCount, nil),
+			},
+			govalid.Group("email", "", validators.NonEmptyRule(), validators.IsEmailRule()),
+		)
+
+		var interrupted *govalid.InterruptedError
+		assert.True(t, errors.As(err, &interrupted))
+		assert.Equal(t, []int{1, 2}, interrupted.Skipped)
+		assert.Equal(t, 1, callCount)
+	})
+
+	t.Run("should panic on unsupported types", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.ValidateCtx(context.Background(), 1)
+		})
+	})
+}
+
+func TestValidateShortCircuitCtx(t *testing.T) {
+	t.Run("should stop at the first error", func(t *testing.T) {
+		callCount := 0
+
+		res, err := govalid.ValidateShortCircuitCtx(context.Background(),
+			createCtxValidatorSpy(&callCount, nil),
+			createCtxValidatorSpy(&callCount, internal.NewValidationError("field1", "test error1")),
+			createCtxValidatorSpy(&callCount, internal.NewValidationError("field2", "test error2")),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, callCount)
+		assert.Equal(t, "field1", res.FirstError().Field())
+	})
+
+	t.Run("should not run anything with an expired context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		callCount := 0
+
+		res, err := govalid.ValidateShortCircuitCtx(ctx,
+			createCtxValidatorSpy(&callCount, nil),
+			createCtxValidatorSpy(&callCount, nil),
+		)
+
+		assert.Equal(t, 0, callCount)
+		assert.True(t, res.IsValid())
+
+		var interrupted *govalid.InterruptedError
+		assert.True(t, errors.As(err, &interrupted))
+		assert.Equal(t, []int{0, 1}, interrupted.Skipped)
+	})
+}
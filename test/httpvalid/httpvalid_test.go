@@ -0,0 +1,295 @@
+package httpvalid_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/httpvalid"
+	"github.com/Palma99/govalid/schema"
+	"github.com/Palma99/govalid/test/codegen/fixtures"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createUser struct {
+	Name  string `json:"name" validate:"required,min_len=3"`
+	Email string `json:"email" validate:"required,email"`
+	Age   int    `json:"age"`
+}
+
+type transfer struct {
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+	Amount float64 `json:"amount"`
+}
+
+func (t transfer) Validate() govalid.ValidationResult {
+	return govalid.Validate(
+		validators.NotEqualTo("to", t.To, "from", t.From),
+		validators.Min("amount", t.Amount, 0.01),
+	)
+}
+
+type transferBatch struct {
+	Transfers []transfer `json:"transfers"`
+}
+
+func (b transferBatch) Validate() govalid.ValidationResult {
+	return govalid.Validate(validators.MinLength("transfers", b.Transfers, 1))
+}
+
+type registeredRequest struct {
+	Code string `json:"code"`
+}
+
+type schemaRequest struct {
+	Title string `json:"title"`
+}
+
+func init() {
+	httpvalid.Register(func(r registeredRequest) govalid.ValidationResult {
+		return govalid.Validate(validators.MatchesRegex("code", r.Code, "^[A-Z]{3}$"))
+	})
+
+	s, err := schema.Parse([]byte(`fields: {title: [required]}`))
+	if err != nil {
+		panic(err)
+	}
+	httpvalid.RegisterSchema[schemaRequest](s)
+}
+
+func post(t *testing.T, handler http.Handler, body string, headers ...string) (*httptest.ResponseRecorder, govalid.ProblemDetails) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	for i := 0; i+1 < len(headers); i += 2 {
+		r.Header.Set(headers[i], headers[i+1])
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	var problem govalid.ProblemDetails
+	if w.Header().Get("Content-Type") == govalid.ProblemDetailsContentType {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	}
+	return w, problem
+}
+
+func okHandler[T any](got *T) http.Handler {
+	return httpvalid.Handler(httpvalid.Options{}, func(w http.ResponseWriter, r *http.Request, value T) {
+		*got = value
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("Should pass the decoded value to the handler", func(t *testing.T) {
+		var got createUser
+		w, _ := post(t, okHandler(&got), `{"name": "Mario", "email": "mario@example.com", "age": 30}`)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, createUser{Name: "Mario", Email: "mario@example.com", Age: 30}, got)
+	})
+
+	t.Run("Should write problem details for invalid values", func(t *testing.T) {
+		var got createUser
+		w, problem := post(t, okHandler(&got), `{"name": "Mo", "email": "mario"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "2 validation errors", problem.Detail)
+		assert.Equal(t, "/name", problem.Errors[0].Pointer)
+		assert.Equal(t, validators.CodeMinLength, problem.Errors[0].Code)
+		assert.Equal(t, validators.CodeEmail, problem.Errors[1].Code)
+		assert.Equal(t, createUser{}, got)
+	})
+
+	t.Run("Should use the Validate method", func(t *testing.T) {
+		var got transfer
+		w, problem := post(t, okHandler(&got), `{"from": "a", "to": "a", "amount": 0}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, []string{"/to", "/amount"}, []string{problem.Errors[0].Pointer, problem.Errors[1].Pointer})
+	})
+
+	t.Run("Should validate nested Validatable values", func(t *testing.T) {
+		var got transferBatch
+		w, problem := post(t, okHandler(&got), `{"transfers": [{"from": "a", "to": "b", "amount": 1}, {"from": "a", "to": "b", "amount": 0}]}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "/transfers/1/amount", problem.Errors[0].Pointer)
+	})
+
+	t.Run("Should report the errors of generated types once", func(t *testing.T) {
+		var got fixtures.Order
+		w, problem := post(t, okHandler(&got), `{
+			"CreatedBy": "admin",
+			"name": "Mario",
+			"email": "mario@example.com",
+			"nickname": "Mario",
+			"address": {},
+			"shipping": {"street": "Via Roma", "city": "Milano"}
+		}`)
+
+		var pointers []string
+		for _, err := range problem.Errors {
+			pointers = append(pointers, err.Pointer)
+		}
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, []string{"/address/street", "/address/city"}, pointers)
+	})
+
+	t.Run("Should use registered validators and schemas", func(t *testing.T) {
+		var registered registeredRequest
+		w, problem := post(t, okHandler(&registered), `{"code": "abc"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, validators.CodeMatchesRegex, problem.Errors[0].Code)
+
+		var fromSchema schemaRequest
+		w, problem = post(t, okHandler(&fromSchema), `{}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "title", problem.Errors[0].Field)
+
+		assert.Panics(t, func() {
+			httpvalid.Register(func(registeredRequest) govalid.ValidationResult { return govalid.Validate() })
+		})
+	})
+
+	t.Run("Should reject bodies that can not be decoded", func(t *testing.T) {
+		var got createUser
+		handler := okHandler(&got)
+
+		tests := []struct {
+			body   string
+			status int
+			detail string
+		}{
+			{``, http.StatusBadRequest, "request body is empty"},
+			{`{"name":`, http.StatusBadRequest, "request body is not valid JSON"},
+			{`{"name": "Mario"} {}`, http.StatusBadRequest, "request body must contain a single JSON value"},
+			{`{"name": 3}`, http.StatusBadRequest, "request body has members of the wrong type"},
+		}
+
+		for _, tc := range tests {
+			w, problem := post(t, handler, tc.body)
+			assert.Equal(t, tc.status, w.Code, tc.body)
+			assert.Equal(t, tc.detail, problem.Detail, tc.body)
+		}
+
+		_, problem := post(t, handler, `{"name": 3}`)
+		assert.Equal(t, "/name", problem.Errors[0].Pointer)
+		assert.Equal(t, "must be of type string", problem.Errors[0].Message)
+	})
+
+	t.Run("Should reject other content types", func(t *testing.T) {
+		var got createUser
+		w, _ := post(t, okHandler(&got), `name=Mario`, "Content-Type", "application/x-www-form-urlencoded")
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+		w, _ = post(t, okHandler(&got), `{"name": "Mario", "email": "mario@example.com"}`, "Content-Type", "application/merge-patch+json")
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Should apply the options", func(t *testing.T) {
+		var handled *httpvalid.Error
+		handler := httpvalid.Handler(httpvalid.Options{
+			MaxBodyBytes:          64,
+			Status:                http.StatusBadRequest,
+			DisallowUnknownFields: true,
+			Locale:                httpvalid.AcceptLanguage,
+		}, func(w http.ResponseWriter, r *http.Request, value createUser) {})
+
+		w, problem := post(t, handler, `{"name": "Mo", "email": "mario@example.com"}`, "Accept-Language", "it-CH, en;q=0.8")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "deve contenere almeno 3 caratteri", problem.Errors[0].Message)
+
+		w, problem = post(t, handler, `{"name": "Mario", "email": "mario@example.com", "role": "admin"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, `invalid request body: unknown field "role"`, problem.Detail)
+
+		w, _ = post(t, handler, `{"name": "`+strings.Repeat("a", 64)+`"}`)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		custom := httpvalid.Handler(httpvalid.Options{
+			ErrorHandler: func(w http.ResponseWriter, r *http.Request, err *httpvalid.Error) {
+				handled = err
+				w.WriteHeader(http.StatusTeapot)
+			},
+		}, func(w http.ResponseWriter, r *http.Request, value createUser) {})
+
+		w, _ = post(t, custom, `{}`)
+		assert.Equal(t, http.StatusTeapot, w.Code)
+		assert.Equal(t, 4, handled.Result.ErrorCount())
+	})
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Should return an Error with the validation result", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": "Mario", "email": "mario"}`))
+
+		_, err := httpvalid.Decode[createUser](httpvalid.Options{}, r)
+
+		var decodeErr *httpvalid.Error
+		require.True(t, errors.As(err, &decodeErr))
+		assert.Equal(t, http.StatusUnprocessableEntity, decodeErr.Status)
+		assert.Equal(t, "email", decodeErr.Result.Errors()[0].Field())
+		assert.Equal(t, "1 validation error", err.Error())
+	})
+
+	t.Run("Should reject null for pointer types", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`null`))
+
+		_, err := httpvalid.Decode[*createUser](httpvalid.Options{}, r)
+		assert.EqualError(t, err, "request body must not be null")
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Should pass the value through the context", func(t *testing.T) {
+		var got createUser
+		var ok bool
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, ok = httpvalid.FromContext[createUser](r.Context())
+		})
+
+		w, _ := post(t, httpvalid.Middleware[createUser](httpvalid.Options{})(next), `{"name": "Mario", "email": "mario@example.com"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, ok)
+		assert.Equal(t, "Mario", got.Name)
+	})
+
+	t.Run("Should not call the next handler for invalid values", func(t *testing.T) {
+		called := false
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true })
+
+		w, _ := post(t, httpvalid.Middleware[createUser](httpvalid.Options{})(next), `{}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.False(t, called)
+	})
+
+	t.Run("Should not find values of other types", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		_, ok := httpvalid.FromContext[createUser](httpvalid.NewContext(r.Context(), transfer{}))
+		assert.False(t, ok)
+	})
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	for header, expected := range map[string]string{
+		"it-CH, it;q=0.9, en;q=0.8": "it-CH",
+		"en;q=0.5, de":              "de",
+		"*":                         "",
+		"":                          "",
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Language", header)
+		assert.Equal(t, expected, httpvalid.AcceptLanguage(r), header)
+	}
+}
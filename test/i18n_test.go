@@ -0,0 +1,78 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalize(t *testing.T) {
+	res := govalid.Validate(
+		validators.NonEmpty("name", ""),
+		validators.MinLength("surname", "Al", 3),
+		validators.Max("age", 130, 120),
+	)
+
+	t.Run("should translate messages using the bundled catalogs", func(t *testing.T) {
+		it := res.Localize("it")
+
+		assert.Equal(t, "non deve essere vuoto", it.Errors()[0].Message())
+		assert.Equal(t, "deve contenere almeno 3 caratteri", it.Errors()[1].Message())
+		assert.Equal(t, "deve essere al massimo 120", it.Errors()[2].Message())
+
+		de := res.Localize("de")
+		assert.Equal(t, "darf nicht leer sein", de.Errors()[0].Message())
+	})
+
+	t.Run("should not change the original result", func(t *testing.T) {
+		res.Localize("it")
+		assert.Equal(t, "must not be empty", res.FirstError().Message())
+	})
+
+	t.Run("should keep field, code, params and value", func(t *testing.T) {
+		err := res.Localize("it").Errors()[1]
+
+		assert.Equal(t, "surname", err.Field())
+		assert.Equal(t, validators.CodeMinLength, err.Code())
+		assert.Equal(t, map[string]any{"min": 3}, err.Params())
+		assert.Equal(t, "Al", err.Value())
+	})
+
+	t.Run("should fall back to the parent locale", func(t *testing.T) {
+		assert.Equal(t, "non deve essere vuoto", res.Localize("it_CH").Errors()[0].Message())
+		assert.Equal(t, "darf nicht leer sein", res.Localize("de-AT").Errors()[0].Message())
+	})
+
+	t.Run("should fall back to the given locales and then to the default one", func(t *testing.T) {
+		assert.Equal(t, "darf nicht leer sein", res.Localize("fr", "de").Errors()[0].Message())
+		assert.Equal(t, "must not be empty", res.Localize("fr").Errors()[0].Message())
+	})
+
+	t.Run("should not translate custom messages", func(t *testing.T) {
+		res := govalid.Validate(validators.NonEmpty("name", "", "name is mandatory"))
+		assert.Equal(t, "name is mandatory", res.Localize("it").Errors()[0].Message())
+	})
+
+	t.Run("should not translate errors without a code", func(t *testing.T) {
+		res := govalid.NewValidationResult(*govalid.NewValidationError("name", "invalid"))
+		assert.Equal(t, "invalid", res.Localize("it").Errors()[0].Message())
+	})
+
+	t.Run("should use catalogs registered later first", func(t *testing.T) {
+		govalid.RegisterCatalog("xx", govalid.MapCatalog{
+			validators.CodeNonEmpty:  "first",
+			validators.CodeMinLength: "{field} too short, {min} required, got {value}",
+		})
+		govalid.RegisterCatalog("xx", govalid.MapCatalog{
+			validators.CodeNonEmpty: "second",
+		})
+
+		xx := res.Localize("xx")
+
+		assert.Equal(t, "second", xx.Errors()[0].Message())
+		assert.Equal(t, "surname too short, 3 required, got Al", xx.Errors()[1].Message())
+		assert.Equal(t, "must be at most 120", xx.Errors()[2].Message())
+	})
+}
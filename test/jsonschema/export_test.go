@@ -0,0 +1,177 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Palma99/govalid/jsonschema"
+	"github.com/Palma99/govalid/schema"
+	_ "github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func toJSON(t *testing.T, s *jsonschema.Schema) string {
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestFromSchema(t *testing.T) {
+	t.Run("Should map rules to JSON Schema keywords", func(t *testing.T) {
+		s, err := schema.Parse([]byte(`
+fields:
+  name:
+    - required
+    - max_len: 20
+  email: [email]
+  age:
+    - min: 18
+    - max: 99
+  code:
+    - matches: "^[A-Z]+$"
+  address.city:
+    - required
+  website: [url]
+  host: [hostname]
+  ip: [ipv4]
+  id: [uuid]
+`))
+		require.NoError(t, err)
+
+		doc, unsupported := jsonschema.FromSchema(s)
+		assert.Empty(t, unsupported)
+
+		assert.JSONEq(t, `{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object",
+			"required": ["name", "address"],
+			"properties": {
+				"name": {"minLength": 1, "maxLength": 20, "pattern": "\\S", "minItems": 1, "maxItems": 20, "minProperties": 1, "maxProperties": 20},
+				"email": {"format": "email"},
+				"age": {"minimum": 18, "maximum": 99},
+				"code": {"pattern": "^[A-Z]+$"},
+				"website": {"format": "uri"},
+				"host": {"format": "hostname"},
+				"ip": {"format": "ipv4"},
+				"id": {"format": "uuid"},
+				"address": {
+					"type": "object",
+					"required": ["city"],
+					"properties": {
+						"city": {"minLength": 1, "pattern": "\\S", "minItems": 1, "minProperties": 1}
+					}
+				}
+			}
+		}`, toJSON(t, doc))
+	})
+
+	t.Run("Should flag rules without an equivalent", func(t *testing.T) {
+		s, err := schema.Parse([]byte(`
+fields:
+  password: []
+  shipping: []
+  password_confirmation:
+    - eq_field: password
+  country:
+    - required_if: shipping true
+  callback:
+    - url: https
+`))
+		require.NoError(t, err)
+
+		doc, unsupported := jsonschema.FromSchema(s)
+		assert.Equal(t, []jsonschema.Unsupported{
+			{Field: "password_confirmation", Rule: "eq_field=password", Reason: "no JSON Schema equivalent"},
+			{Field: "country", Rule: "required_if=shipping true", Reason: "no JSON Schema equivalent"},
+			{Field: "callback", Rule: "url=https", Reason: "allowed schemes have no JSON Schema equivalent"},
+		}, unsupported)
+		assert.Equal(t, "password_confirmation: eq_field=password: no JSON Schema equivalent", unsupported[0].String())
+
+		assert.Empty(t, doc.Required)
+	})
+}
+
+type exportAddress struct {
+	City string `json:"city" validate:"required,max_len=10"`
+}
+
+type exportCategory struct {
+	Name   string          `json:"name" validate:"required"`
+	Parent *exportCategory `json:"parent"`
+}
+
+type exportProduct struct {
+	Sku        string            `json:"sku" validate:"required,matches=^[A-Z]{3}$"`
+	Price      float64           `json:"price" validate:"min=0"`
+	Quantity   int               `json:"quantity" validate:"min=1,max=10"`
+	Tags       []string          `json:"tags" validate:"required,max_len=5"`
+	Labels     map[string]string `json:"labels"`
+	Address    exportAddress     `json:"address"`
+	Warehouse  *exportAddress    `json:"warehouse"`
+	Category   exportCategory    `json:"category"`
+	Confirm    string            `json:"confirm" validate:"eq_field=Sku"`
+	Available  bool              `json:"available"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Ignored    string            `json:"-"`
+	unexported string
+}
+
+func TestFromStruct(t *testing.T) {
+	t.Run("Should convert a struct using its tags", func(t *testing.T) {
+		doc, unsupported := jsonschema.FromStruct((*exportProduct)(nil))
+
+		assert.Equal(t, []jsonschema.Unsupported{
+			{Field: "exportProduct.confirm", Rule: "eq_field=Sku", Reason: "no JSON Schema equivalent"},
+		}, unsupported)
+
+		assert.JSONEq(t, `{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object",
+			"required": ["sku", "tags"],
+			"properties": {
+				"sku": {"type": "string", "minLength": 1, "pattern": "\\S", "allOf": [{"pattern": "^[A-Z]{3}$"}]},
+				"price": {"type": "number", "minimum": 0},
+				"quantity": {"type": "integer", "minimum": 1, "maximum": 10},
+				"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 5},
+				"labels": {"type": ["object", "null"], "additionalProperties": {"type": "string"}},
+				"address": {"$ref": "#/$defs/exportAddress"},
+				"warehouse": {"anyOf": [{"$ref": "#/$defs/exportAddress"}, {"type": "null"}]},
+				"category": {"$ref": "#/$defs/exportCategory"},
+				"confirm": {"type": "string"},
+				"available": {"type": "boolean"},
+				"created_at": {"type": "string", "format": "date-time"}
+			},
+			"$defs": {
+				"exportAddress": {
+					"type": "object",
+					"required": ["city"],
+					"properties": {
+						"city": {"type": "string", "minLength": 1, "pattern": "\\S", "maxLength": 10}
+					}
+				},
+				"exportCategory": {
+					"type": "object",
+					"required": ["name"],
+					"properties": {
+						"name": {"type": "string", "minLength": 1, "pattern": "\\S"},
+						"parent": {"anyOf": [{"$ref": "#/$defs/exportCategory"}, {"type": "null"}]}
+					}
+				}
+			}
+		}`, toJSON(t, doc))
+	})
+
+	t.Run("Should reference the document for the root type", func(t *testing.T) {
+		doc, _ := jsonschema.FromStruct(exportCategory{})
+
+		assert.Nil(t, doc.Defs)
+		assert.Equal(t, "#", doc.Properties["parent"].AnyOf[0].Ref)
+	})
+
+	t.Run("Should panic if the value is not a struct", func(t *testing.T) {
+		assert.Panics(t, func() { jsonschema.FromStruct("not a struct") })
+		assert.Panics(t, func() { jsonschema.FromStruct(nil) })
+	})
+}
@@ -0,0 +1,261 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/jsonschema"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const orderSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"required": ["id", "customer", "items"],
+	"properties": {
+		"id": {"type": "string", "pattern": "^ORD-[0-9]+$"},
+		"status": {"enum": ["new", "paid", "shipped"]},
+		"version": {"const": 2},
+		"customer": {"$ref": "#/$defs/customer"},
+		"items": {
+			"type": "array",
+			"minItems": 1,
+			"maxItems": 3,
+			"items": {
+				"type": "object",
+				"required": ["sku", "quantity"],
+				"properties": {
+					"sku": {"type": "string", "minLength": 3, "maxLength": 5},
+					"quantity": {"type": "integer", "minimum": 1, "maximum": 10},
+					"discount": {"type": "number", "exclusiveMinimum": 0, "exclusiveMaximum": 1}
+				},
+				"additionalProperties": false
+			}
+		}
+	},
+	"$defs": {
+		"customer": {
+			"type": "object",
+			"required": ["name"],
+			"properties": {
+				"name": {"type": "string", "minLength": 1},
+				"email": {"type": ["string", "null"], "format": "email"}
+			}
+		}
+	}
+}`
+
+func compile(t *testing.T, schema string) *jsonschema.Validator {
+	validator, err := jsonschema.Compile([]byte(schema))
+	require.NoError(t, err)
+	return validator
+}
+
+func pointers(res govalid.ValidationResult) []string {
+	var result []string
+	for _, err := range res.Errors() {
+		result = append(result, err.Path().JSONPointer()+" "+err.Code())
+	}
+	return result
+}
+
+func TestValidator(t *testing.T) {
+	validator := compile(t, orderSchema)
+
+	t.Run("Should return no errors for a valid document", func(t *testing.T) {
+		res, err := validator.ValidateJSON([]byte(`{
+			"id": "ORD-1",
+			"status": "paid",
+			"version": 2.0,
+			"customer": {"name": "Mario", "email": null},
+			"items": [{"sku": "ABC", "quantity": 2, "discount": 0.5}]
+		}`))
+		require.NoError(t, err)
+		assert.True(t, res.IsValid(), res.Errors())
+	})
+
+	t.Run("Should return errors with JSON pointer paths", func(t *testing.T) {
+		res, err := validator.ValidateJSON([]byte(`{
+			"id": "ORD-X",
+			"status": "lost",
+			"version": 1,
+			"customer": {"email": 3},
+			"items": [
+				{"sku": "ABC", "quantity": 2},
+				{"sku": "AB", "quantity": 1.5, "discount": 1, "color": "red"},
+				{"quantity": 11}
+			]
+		}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"/customer/name required",
+			"/customer/email type",
+			"/id matches_regex",
+			"/items/1/discount exclusive_max",
+			"/items/1/quantity type",
+			"/items/1/sku min_length",
+			"/items/1/color not_allowed",
+			"/items/2/sku required",
+			"/items/2/quantity max",
+			"/status enum",
+			"/version const",
+		}, pointers(res))
+
+		assert.Equal(t, "must be one of \"new\", \"paid\", \"shipped\"", res.FieldErrors("status")[0].Message())
+		assert.Equal(t, "must be of type string or null", res.FieldErrors("customer.email")[0].Message())
+		assert.Equal(t, "items[1].sku", res.Errors()[5].Field())
+	})
+
+	t.Run("Should report errors on the document itself with an empty path", func(t *testing.T) {
+		res := validator.Validate([]any{})
+
+		assert.Equal(t, []string{" type"}, pointers(res))
+		assert.Equal(t, "must be of type object", res.Errors()[0].Message())
+	})
+
+	t.Run("Should check array and object sizes", func(t *testing.T) {
+		res := validator.Validate(map[string]any{
+			"id":       "ORD-1",
+			"customer": map[string]any{"name": "Mario"},
+			"items":    []any{},
+		})
+		assert.Equal(t, []string{"/items min_items"}, pointers(res))
+
+		v := compile(t, `{"minProperties": 1, "maxProperties": 1}`)
+		assert.Equal(t, []string{" min_properties"}, pointers(v.Validate(map[string]any{})))
+		assert.Equal(t, []string{" max_properties"}, pointers(v.Validate(map[string]any{"a": 1, "b": 2})))
+	})
+
+	t.Run("Should count characters, not bytes", func(t *testing.T) {
+		v := compile(t, `{"maxLength": 3}`)
+		assert.True(t, v.Validate("àèì").IsValid())
+		assert.False(t, v.Validate("àèìò").IsValid())
+	})
+
+	t.Run("Should return an error for invalid JSON", func(t *testing.T) {
+		_, err := validator.ValidateJSON([]byte(`{"id":`))
+		assert.Error(t, err)
+
+		_, err = validator.ValidateJSON([]byte(`{} {}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should translate messages", func(t *testing.T) {
+		res := validator.Validate(map[string]any{}).Localize("it")
+		assert.Equal(t, "è obbligatorio", res.Errors()[0].Message())
+	})
+
+	t.Run("Should validate values decoded with json.Number", func(t *testing.T) {
+		v := compile(t, `{"type": "integer", "maximum": 10}`)
+		assert.True(t, v.Validate(json.Number("10")).IsValid())
+		assert.Equal(t, validators.CodeMax, v.Validate(json.Number("11")).Errors()[0].Code())
+		assert.Equal(t, jsonschema.CodeType, v.Validate(json.Number("1.5")).Errors()[0].Code())
+	})
+}
+
+func TestCombinators(t *testing.T) {
+	t.Run("Should return the errors of all allOf schemas", func(t *testing.T) {
+		v := compile(t, `{"allOf": [{"minLength": 2}, {"pattern": "^[a-z]+$"}]}`)
+		assert.Equal(t, []string{" min_length", " matches_regex"}, pointers(v.Validate("1")))
+	})
+
+	t.Run("Should require at least one anyOf schema", func(t *testing.T) {
+		v := compile(t, `{"anyOf": [{"type": "string"}, {"type": "integer"}]}`)
+		assert.True(t, v.Validate(json.Number("1")).IsValid())
+		assert.Equal(t, []string{" any_of"}, pointers(v.Validate(true)))
+	})
+
+	t.Run("Should require exactly one oneOf schema", func(t *testing.T) {
+		v := compile(t, `{"oneOf": [{"type": "number"}, {"type": "integer"}]}`)
+		assert.True(t, v.Validate(1.5).IsValid())
+
+		res := v.Validate(float64(2))
+		assert.Equal(t, []string{" one_of"}, pointers(res))
+		matches, _ := res.Errors()[0].Param("matches")
+		assert.Equal(t, 2, matches)
+	})
+
+	t.Run("Should reject values matching not", func(t *testing.T) {
+		v := compile(t, `{"not": {"type": "null"}}`)
+		assert.True(t, v.Validate("x").IsValid())
+		assert.Equal(t, []string{" not"}, pointers(v.Validate(nil)))
+	})
+
+	t.Run("Should support boolean schemas", func(t *testing.T) {
+		v := compile(t, `{"properties": {"a": true, "b": false}}`)
+		assert.Equal(t, []string{"/b not_allowed"}, pointers(v.Validate(map[string]any{"a": 1, "b": 2})))
+	})
+}
+
+func TestReferences(t *testing.T) {
+	t.Run("Should resolve recursive references", func(t *testing.T) {
+		v := compile(t, `{
+			"type": "object",
+			"required": ["name"],
+			"properties": {
+				"name": {"type": "string"},
+				"children": {"type": "array", "items": {"$ref": "#"}}
+			}
+		}`)
+
+		res := v.Validate(map[string]any{
+			"name": "root",
+			"children": []any{
+				map[string]any{"name": "child", "children": []any{map[string]any{}}},
+			},
+		})
+		assert.Equal(t, []string{"/children/0/children/0/name required"}, pointers(res))
+	})
+
+	t.Run("Should resolve escaped pointers and definitions", func(t *testing.T) {
+		v := compile(t, `{
+			"$ref": "#/definitions/a~1b",
+			"definitions": {"a/b": {"type": "string"}}
+		}`)
+		assert.Equal(t, []string{" type"}, pointers(v.Validate(false)))
+	})
+
+	t.Run("Should return compile errors", func(t *testing.T) {
+		tests := map[string]string{
+			`{"$ref": "https://example.com/schema.json"}`:                  `jsonschema: /$ref: remote reference "https://example.com/schema.json" is not supported`,
+			`{"$ref": "#/$defs/missing"}`:                                  `jsonschema: /$ref: reference "#/$defs/missing" not found`,
+			`{"type": "text"}`:                                             `jsonschema: /type: unknown type "text"`,
+			`{"properties": {"a": {"pattern": "(?=a)"}}}`:                  "jsonschema: /properties/a/pattern: invalid pattern: error parsing regexp: invalid or unsupported Perl syntax: `(?=`",
+			`{"minLength": -1}`:                                            `jsonschema: /minLength: must be a non-negative integer`,
+			`{"anyOf": []}`:                                                `jsonschema: /anyOf: must be a non-empty array`,
+			`{"items": [{"type": "string"}]}`:                              `jsonschema: /items: the array form of items is not supported`,
+			`"string"`:                                                     `jsonschema: #: a schema must be an object or a boolean`,
+			`{"properties": {"a": {"required": "a"}}, "x": 1}`:             `jsonschema: /properties/a/required: must be an array of strings`,
+			`{"$defs": {"a": {"$ref": "#/$defs/a"}}, "$ref": "#/$defs/a"}`: `jsonschema: /$defs/a/$ref: circular reference "#/$defs/a", it must go through an object or array keyword`,
+			`{"$defs": {"a": {"$ref": "#/$defs/b"}, "b": {"allOf": [{"$ref": "#/$defs/a"}]}}}`: `jsonschema: /$defs/b/allOf/0/$ref: circular reference "#/$defs/a", it must go through an object or array keyword`,
+			`{"anyOf": [{"type": "string"}, {"$ref": "#"}]}`:                                   `jsonschema: /anyOf/1/$ref: circular reference "#", it must go through an object or array keyword`,
+		}
+
+		for schema, message := range tests {
+			_, err := jsonschema.Compile([]byte(schema))
+
+			var compileErr *jsonschema.CompileError
+			require.True(t, errors.As(err, &compileErr), schema)
+			assert.Equal(t, message, err.Error())
+		}
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Run("Should validate payloads with an exported struct schema", func(t *testing.T) {
+		doc, _ := jsonschema.FromStruct(exportProduct{})
+		data, err := json.Marshal(doc)
+		require.NoError(t, err)
+
+		v := compile(t, string(data))
+		res, err := v.ValidateJSON([]byte(`{"sku": "abc", "tags": [], "quantity": 0, "warehouse": null}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"/quantity min", "/sku matches_regex", "/tags min_items"}, pointers(res))
+	})
+}
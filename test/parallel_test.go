@@ -0,0 +1,160 @@
+package govalid_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSlowValidator(delay time.Duration, testErr *internal.ValidationError) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		time.Sleep(delay)
+		return testErr
+	}
+}
+
+func TestValidateParallel(t *testing.T) {
+	t.Run("should return errors in the same order as Validate", func(t *testing.T) {
+		var validations []govalid.ValidationFunc
+		for i := 0; i < 20; i++ {
+			delay := time.Duration(20-i) * time.Millisecond
+			validations = append(validations,
+				createSlowValidator(delay, internal.NewValidationError(fmt.Sprintf("field%d", i), "error")),
+			)
+		}
+
+		expected := govalid.Validate(validations)
+		res := govalid.ValidateParallel(govalid.ParallelOptions{Workers: 8}, validations)
+
+		assert.Equal(t, expected.Errors(), res.Errors())
+	})
+
+	t.Run("should accept the same types as Validate", func(t *testing.T) {
+		res := govalid.ValidateParallel(govalid.ParallelOptions{},
+			validators.NonEmpty("name", ""),
+			govalid.Group("email", "",
+				validators.NonEmptyRule(),
+				validators.IsEmailRule(),
+			),
+		)
+
+		assert.Equal(t, 3, res.ErrorCount())
+		assert.Equal(t, "name", res.FirstError().Field())
+	})
+
+	t.Run("should not exceed the number of workers", func(t *testing.T) {
+		var running, maxRunning atomic.Int64
+
+		var validations []govalid.ValidationFunc
+		for i := 0; i < 30; i++ {
+			validations = append(validations, func() *internal.ValidationError {
+				current := running.Add(1)
+				for {
+					observed := maxRunning.Load()
+					if current <= observed || maxRunning.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				running.Add(-1)
+				return nil
+			})
+		}
+
+		res := govalid.ValidateParallel(govalid.ParallelOptions{Workers: 3}, validations)
+
+		assert.True(t, res.IsValid())
+		assert.LessOrEqual(t, maxRunning.Load(), int64(3))
+	})
+
+	t.Run("should return no errors without validations", func(t *testing.T) {
+		res := govalid.ValidateParallel(govalid.ParallelOptions{})
+		assert.True(t, res.IsValid())
+	})
+
+	t.Run("should panic on unsupported types", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.ValidateParallel(govalid.ParallelOptions{}, 1)
+		})
+	})
+}
+
+func TestValidateParallelFailFast(t *testing.T) {
+	t.Run("should return the same error as ValidateShortCircuit", func(t *testing.T) {
+		// The last validation fails first, the ones before it still run
+		lastFailed := make(chan struct{})
+		validations := []govalid.ValidationFunc{
+			func() *internal.ValidationError {
+				<-lastFailed
+				return nil
+			},
+			func() *internal.ValidationError {
+				<-lastFailed
+				return internal.NewValidationError("field1", "error")
+			},
+			func() *internal.ValidationError {
+				defer close(lastFailed)
+				return internal.NewValidationError("field2", "error")
+			},
+		}
+
+		res := govalid.ValidateParallel(govalid.ParallelOptions{Workers: 3, FailFast: true}, validations)
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "field1", res.FirstError().Field())
+	})
+
+	t.Run("should cancel outstanding validations after the first error", func(t *testing.T) {
+		var cancelled atomic.Int64
+		started := make(chan struct{})
+		blocking := govalid.ValidationFuncCtx(func(ctx context.Context) *internal.ValidationError {
+			started <- struct{}{}
+			select {
+			case <-ctx.Done():
+				cancelled.Add(1)
+			case <-time.After(5 * time.Second):
+				// Only reached if the cancellation is missing
+			}
+			return nil
+		})
+
+		res := govalid.ValidateParallel(govalid.ParallelOptions{Workers: 3, FailFast: true},
+			govalid.ValidationFuncCtx(func(ctx context.Context) *internal.ValidationError {
+				// Fails once the others are running
+				<-started
+				<-started
+				return internal.NewValidationError("field1", "error")
+			}),
+			blocking,
+			blocking,
+		)
+
+		assert.Equal(t, int64(2), cancelled.Load())
+		assert.Equal(t, "field1", res.FirstError().Field())
+	})
+
+	t.Run("should not start validations after the first error", func(t *testing.T) {
+		var calls atomic.Int64
+
+		var validations []govalid.ValidationFunc
+		validations = append(validations, createSlowValidator(0, internal.NewValidationError("field0", "error")))
+		for i := 0; i < 100; i++ {
+			validations = append(validations, func() *internal.ValidationError {
+				calls.Add(1)
+				return nil
+			})
+		}
+
+		res := govalid.ValidateParallel(govalid.ParallelOptions{Workers: 1, FailFast: true}, validations)
+
+		assert.Equal(t, "field0", res.FirstError().Field())
+		assert.Zero(t, calls.Load())
+	})
+}
@@ -0,0 +1,177 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPath(t *testing.T) {
+	path := govalid.NewPath(
+		govalid.FieldSegment("order"),
+		govalid.FieldSegment("items"),
+		govalid.IndexSegment(2),
+		govalid.FieldSegment("labels"),
+		govalid.KeySegment("a/b"),
+	)
+
+	t.Run("should render in bracket notation", func(t *testing.T) {
+		assert.Equal(t, "order.items[2].labels[a/b]", path.Bracket())
+		assert.Equal(t, path.Bracket(), path.String())
+	})
+
+	t.Run("should render in dotted notation", func(t *testing.T) {
+		assert.Equal(t, "order.items.2.labels.a/b", path.Dotted())
+	})
+
+	t.Run("should render as JSON Pointer", func(t *testing.T) {
+		assert.Equal(t, "/order/items/2/labels/a~1b", path.JSONPointer())
+		assert.Equal(t, "/a~0b", govalid.NewPath(govalid.FieldSegment("a~b")).JSONPointer())
+		assert.Equal(t, "", govalid.NewPath().JSONPointer())
+	})
+
+	t.Run("should parse bracket notation", func(t *testing.T) {
+		assert.Equal(t, path, govalid.ParsePath("order.items[2].labels[a/b]"))
+		assert.Equal(t, govalid.NewPath(govalid.IndexSegment(0), govalid.IndexSegment(1)), govalid.ParsePath("[0][1]"))
+		assert.Nil(t, govalid.ParsePath(""))
+	})
+
+	t.Run("should escape dots and brackets in names and keys", func(t *testing.T) {
+		escaped := govalid.NewPath(
+			govalid.FieldSegment("labels"),
+			govalid.KeySegment("a.b"),
+			govalid.KeySegment("c]d"),
+			govalid.FieldSegment("e.f[g]"),
+			govalid.KeySegment(`h\`),
+			govalid.IndexSegment(3),
+		)
+
+		assert.Equal(t, `labels[a.b][c\]d].e\.f\[g][h\\][3]`, escaped.Bracket())
+		assert.Equal(t, escaped, govalid.ParsePath(escaped.Bracket()))
+		assert.Equal(t, "/labels/a.b/c]d/e.f[g]/h\\/3", escaped.JSONPointer())
+	})
+
+	t.Run("should escape map keys made of digits", func(t *testing.T) {
+		keyed := govalid.NewPath(govalid.FieldSegment("labels"), govalid.KeySegment("2"), govalid.IndexSegment(2))
+
+		assert.Equal(t, `labels[\2][2]`, keyed.Bracket())
+		assert.Equal(t, keyed, govalid.ParsePath(keyed.Bracket()))
+	})
+
+	t.Run("should expose segment details", func(t *testing.T) {
+		assert.Equal(t, internal.FieldKind, path[0].Kind())
+		assert.Equal(t, internal.IndexKind, path[2].Kind())
+		assert.Equal(t, 2, path[2].Index())
+		assert.Equal(t, internal.KeyKind, path[4].Kind())
+		assert.Equal(t, "a/b", path[4].String())
+	})
+
+	t.Run("should not modify the original path when appending", func(t *testing.T) {
+		base := govalid.ParsePath("items")
+		first := base.Append(govalid.IndexSegment(0))
+		second := base.Append(govalid.IndexSegment(1))
+
+		assert.Equal(t, "items", base.String())
+		assert.Equal(t, "items[0]", first.String())
+		assert.Equal(t, "items[1]", second.String())
+	})
+}
+
+func TestValidationErrorPath(t *testing.T) {
+	t.Run("should parse the field name", func(t *testing.T) {
+		err := internal.NewValidationError("items[2].sku", "required")
+
+		assert.Equal(t, "items[2].sku", err.Field())
+		assert.Equal(t, "/items/2/sku", err.Path().JSONPointer())
+		assert.Equal(t, "items.2.sku", err.Path().Dotted())
+	})
+
+	t.Run("should keep names that are not written as paths", func(t *testing.T) {
+		for _, name := range []string{`C:\dir`, "a.", "[+2]"} {
+			err := internal.NewValidationError(name, "required")
+
+			assert.Equal(t, name, err.Field())
+			assert.Equal(t, govalid.NewPath(govalid.FieldSegment(name)), err.Path())
+		}
+	})
+
+	t.Run("should render a prefixed plain name as a path", func(t *testing.T) {
+		err := internal.NewValidationError("a.", "required").WithPrefix(govalid.ParsePath("items[2]"))
+
+		assert.Equal(t, `items[2].a\.`, err.Field())
+		assert.Equal(t, "/items/2/a.", err.Path().JSONPointer())
+	})
+
+	t.Run("should prepend a prefix", func(t *testing.T) {
+		err := internal.NewValidationError("sku", "required").WithPrefix(govalid.ParsePath("items[2]"))
+		assert.Equal(t, "items[2].sku", err.Field())
+	})
+}
+
+func TestNest(t *testing.T) {
+	t.Run("should prefix the errors of nested validations", func(t *testing.T) {
+		res := govalid.Validate(
+			validators.NonEmpty("id", ""),
+			govalid.Nest("address",
+				validators.NonEmpty("city", ""),
+				govalid.Group("zip", "", validators.NonEmptyRule()),
+			),
+			govalid.Nest("items[1]", validators.NonEmpty("sku", "")),
+		)
+
+		assert.Equal(t, 4, res.ErrorCount())
+		assert.Equal(t, "id", res.Errors()[0].Field())
+		assert.Equal(t, "address.city", res.Errors()[1].Field())
+		assert.Equal(t, "address.zip", res.Errors()[2].Field())
+		assert.Equal(t, "/items/1/sku", res.Errors()[3].Path().JSONPointer())
+	})
+
+	t.Run("should not change errors shared between calls", func(t *testing.T) {
+		shared := internal.NewValidationError("sku", "must not be empty")
+		validation := govalid.ValidationFunc(func() *internal.ValidationError { return shared })
+
+		nested := govalid.Nest("items[0]", validation)
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, "items[0].sku", govalid.Validate(nested).Errors()[0].Field())
+		}
+
+		each := govalid.Each("items", []string{"a"}, func(string, any) govalid.ValidationFunc { return validation })
+		assert.Equal(t, "items[0]", govalid.Validate(each).Errors()[0].Field())
+		assert.Equal(t, "sku", shared.Field())
+	})
+
+	t.Run("should panic on unsupported types", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.Nest("address", 1)
+		})
+	})
+}
+
+func TestValidationResultWithPrefix(t *testing.T) {
+	child := govalid.Validate(
+		validators.NonEmpty("street", ""),
+		validators.NonEmpty("city", ""),
+	)
+
+	t.Run("should prefix all errors", func(t *testing.T) {
+		res := child.WithPrefix("shipping.address")
+
+		assert.False(t, res.IsFieldValid("shipping.address.street"))
+		assert.False(t, res.IsFieldValid("shipping.address.city"))
+		assert.Equal(t, "street", child.Errors()[0].Field())
+	})
+
+	t.Run("should merge results", func(t *testing.T) {
+		res := govalid.Validate(validators.NonEmpty("id", "")).Merge(
+			child.WithPrefix("billing"),
+			child.WithPrefix("shipping"),
+		)
+
+		assert.Equal(t, 5, res.ErrorCount())
+		assert.Equal(t, "billing.street", res.Errors()[1].Field())
+		assert.Equal(t, "shipping.city", res.Errors()[4].Field())
+	})
+}
@@ -0,0 +1,84 @@
+package govalid_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProblemDetails(t *testing.T) {
+	res := govalid.Validate(
+		validators.NonEmpty("name", ""),
+		validators.MinLength("items[2].sku", "AB", 3),
+		validators.NonEmpty("a/b", ""),
+	)
+
+	problem := govalid.NewProblemDetails(http.StatusUnprocessableEntity, res)
+
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, "Unprocessable Entity", problem.Title)
+	assert.Equal(t, 422, problem.Status)
+	assert.Equal(t, "3 validation errors", problem.Detail)
+	assert.Equal(t, []govalid.ProblemError{
+		{Field: "name", Code: "non_empty", Message: "must not be empty", Pointer: "/name"},
+		{
+			Field:   "items[2].sku",
+			Code:    "min_length",
+			Message: "must be at least 3 characters",
+			Params:  map[string]any{"min": 3},
+			Pointer: "/items/2/sku",
+		},
+		{Field: "a/b", Code: "non_empty", Message: "must not be empty", Pointer: "/a~1b"},
+	}, problem.Errors)
+}
+
+func TestWriteProblemDetails(t *testing.T) {
+	t.Run("should write an application/problem+json response", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		res := govalid.Validate(validators.NonEmpty("address.city", ""))
+
+		err := govalid.WriteProblemDetails(rec, http.StatusBadRequest, res)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"type": "about:blank",
+			"title": "Bad Request",
+			"status": 400,
+			"detail": "1 validation error",
+			"errors": [
+				{"field": "address.city", "code": "non_empty", "message": "must not be empty", "pointer": "/address/city"}
+			]
+		}`, rec.Body.String())
+	})
+
+	t.Run("should write an empty errors array for valid results", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		err := govalid.WriteProblemDetails(rec, http.StatusBadRequest, govalid.NewValidationResult())
+		require.NoError(t, err)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, []any{}, body["errors"])
+	})
+
+	t.Run("should default a zero status to 400", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		require.NotPanics(t, func() { require.NoError(t, govalid.ProblemDetails{}.Write(rec)) })
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":400`)
+
+		problem := govalid.NewProblemDetails(0, govalid.NewValidationResult())
+		assert.Equal(t, http.StatusBadRequest, problem.Status)
+		assert.Equal(t, "Bad Request", problem.Title)
+	})
+}
@@ -0,0 +1,172 @@
+package govalid_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func betweenRule(params govalid.Params) (govalid.ValidationRule, error) {
+	bounds, err := params.Ints(2)
+	if err != nil {
+		return nil, err
+	}
+	return func(field string, value any) govalid.ValidationFunc {
+		return govalid.GroupShortCircuit(field, value, validators.MinRule(bounds[0]), validators.MaxRule(bounds[1]))
+	}, nil
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("Should build a registered rule from its reference", func(t *testing.T) {
+		registry := govalid.NewRegistry()
+		registry.MustRegister("between", betweenRule)
+
+		rule, err := registry.Rule("between:1,10", nil)
+		assert.NoError(t, err)
+
+		assert.True(t, govalid.Validate(rule("age", 5)).IsValid())
+
+		res := govalid.Validate(rule("age", 11))
+		assert.Equal(t, "age", res.Errors()[0].Field())
+		assert.Equal(t, validators.CodeMax, res.Errors()[0].Code())
+	})
+
+	t.Run("Should accept '=' as separator, like struct tags", func(t *testing.T) {
+		registry := govalid.NewRegistry()
+		registry.MustRegister("between", betweenRule)
+
+		rule, err := registry.Rule("between=1,10", nil)
+		assert.NoError(t, err)
+		assert.False(t, govalid.Validate(rule("age", 0)).IsValid())
+	})
+
+	t.Run("Should reject duplicate names", func(t *testing.T) {
+		registry := govalid.NewRegistry()
+		assert.NoError(t, registry.Register("between", betweenRule))
+
+		err := registry.Register("between", betweenRule)
+		assert.True(t, errors.Is(err, govalid.ErrDuplicateRule))
+		assert.Panics(t, func() { registry.MustRegister("between", betweenRule) })
+	})
+
+	t.Run("Should reject invalid names", func(t *testing.T) {
+		registry := govalid.NewRegistry()
+		assert.Error(t, registry.Register("", betweenRule))
+		assert.Error(t, registry.Register("min:3", betweenRule))
+	})
+
+	t.Run("Should report unknown rules and invalid parameters", func(t *testing.T) {
+		registry := govalid.NewRegistry()
+		registry.MustRegister("between", betweenRule)
+
+		_, err := registry.Rule("unknown", nil)
+		assert.True(t, errors.Is(err, govalid.ErrUnknownRule))
+
+		_, err = registry.Rule("between:1", nil)
+		assert.ErrorContains(t, err, "expected 2 parameters, got 1")
+
+		_, err = registry.Rule("between:a,b", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should list and look up rules", func(t *testing.T) {
+		registry := govalid.NewRegistry()
+		registry.MustRegister("between", betweenRule)
+		registry.MustRegister("any", func(govalid.Params) (govalid.ValidationRule, error) {
+			return validators.NonEmptyRule(), nil
+		})
+
+		assert.Equal(t, []string{"any", "between"}, registry.Names())
+
+		_, ok := registry.Lookup("between")
+		assert.True(t, ok)
+		_, ok = registry.Lookup("missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("Should pre-register the built-in rules", func(t *testing.T) {
+		names := govalid.DefaultRegistry.Names()
+		for _, name := range []string{"required", "min_len", "max_len", "min", "max", "matches", "email", "required_if", "required_unless", "eq_field"} {
+			assert.Contains(t, names, name)
+		}
+
+		rule, err := govalid.DefaultRegistry.Rule("min_len:3", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, validators.CodeMinLength, govalid.Validate(rule("name", "ab")).Errors()[0].Code())
+	})
+
+	t.Run("Should make custom rules available in struct tags", func(t *testing.T) {
+		// DefaultRegistry is global, the rule is already registered when the test runs again
+		if err := govalid.DefaultRegistry.Register("test_between", betweenRule); !errors.Is(err, govalid.ErrDuplicateRule) {
+			assert.NoError(t, err)
+		}
+
+		type product struct {
+			Quantity int `json:"quantity" validate:"test_between=1,5"`
+		}
+
+		res := govalid.ValidateStruct(product{Quantity: 6})
+		assert.Equal(t, "quantity", res.Errors()[0].Field())
+		assert.Equal(t, validators.CodeMax, res.Errors()[0].Code())
+	})
+
+	t.Run("Should keep commas in tag parameters", func(t *testing.T) {
+		type code struct {
+			Value string `json:"value" validate:"required,matches=^[A-Z]{2,3}$,min_len=2"`
+		}
+
+		assert.True(t, govalid.ValidateStruct(code{Value: "ABC"}).IsValid())
+
+		res := govalid.ValidateStruct(code{Value: "ABCD"})
+		assert.Equal(t, 1, len(res.Errors()))
+		assert.Equal(t, validators.CodeMatchesRegex, res.Errors()[0].Code())
+	})
+}
+
+func TestParams(t *testing.T) {
+	t.Run("Should split and parse parameters", func(t *testing.T) {
+		params := govalid.NewParams(" 1, 2.5 ", nil)
+
+		assert.Equal(t, " 1, 2.5 ", params.Raw())
+		assert.Equal(t, []string{"1", "2.5"}, params.List())
+
+		floats, err := params.Floats(2)
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{1, 2.5}, floats)
+
+		_, err = params.Ints(2)
+		assert.Error(t, err)
+
+		n, err := govalid.NewParams("3", nil).Int()
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		assert.Nil(t, govalid.NewParams("", nil).List())
+	})
+
+	t.Run("Should look up other fields", func(t *testing.T) {
+		params := govalid.NewParams("", func(name string) (any, bool) {
+			return "value", name == "Other"
+		})
+
+		value, ok := params.Lookup("Other")
+		assert.True(t, ok)
+		assert.Equal(t, "value", value)
+
+		_, ok = govalid.NewParams("", nil).Lookup("Other")
+		assert.False(t, ok)
+	})
+
+	t.Run("Should split references at the first separator", func(t *testing.T) {
+		name, params := govalid.ParseRuleSpec("matches=^a=b$")
+		assert.Equal(t, "matches", name)
+		assert.Equal(t, "^a=b$", params)
+
+		name, params = govalid.ParseRuleSpec(" required ")
+		assert.Equal(t, "required", name)
+		assert.Equal(t, "", params)
+	})
+}
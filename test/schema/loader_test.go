@@ -0,0 +1,252 @@
+package schema_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/schema"
+	_ "github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const yamlSchema = `
+fields:
+  name:
+    - required
+    - min_len: 3
+  email: [required, email]
+  age:
+    - min: 18
+      message: you are too young
+  address.city:
+    - max_len: 10
+  password: []
+  password_confirmation:
+    - eq_field: password
+`
+
+const jsonSchema = `{
+  "fields": {
+    "name": ["required", {"min_len": 3}],
+    "email": ["required", "email"],
+    "age": [{"min": 18, "message": "you are too young"}],
+    "address.city": [{"max_len": 10}],
+    "password": [],
+    "password_confirmation": [{"eq_field": "password"}]
+  }
+}`
+
+type address struct {
+	City string `json:"city"`
+}
+
+type user struct {
+	Name                 string   `json:"name"`
+	Email                string   `json:"email"`
+	Age                  int      `json:"age"`
+	Address              *address `json:"address"`
+	Password             string   `json:"password"`
+	PasswordConfirmation string   `json:"password_confirmation"`
+}
+
+func TestParse(t *testing.T) {
+	for name, source := range map[string]string{"yaml": yamlSchema, "json": jsonSchema} {
+		t.Run("should parse "+name+" keeping the field order", func(t *testing.T) {
+			s, err := schema.Parse([]byte(source))
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"name", "email", "age", "address.city", "password", "password_confirmation"}, s.Fields())
+		})
+	}
+
+	t.Run("should return the rules of a field", func(t *testing.T) {
+		s, err := schema.Parse([]byte(yamlSchema))
+		require.NoError(t, err)
+
+		assert.Equal(t, []schema.Rule{{Name: "required"}, {Name: "min_len", Param: "3"}}, s.Rules("name"))
+		assert.Equal(t, []schema.Rule{{Name: "min", Param: "18", Message: "you are too young"}}, s.Rules("age"))
+		assert.Nil(t, s.Rules("missing"))
+	})
+}
+
+func TestValidateMap(t *testing.T) {
+	s, err := schema.Parse([]byte(yamlSchema))
+	require.NoError(t, err)
+
+	t.Run("should return no errors for a valid map", func(t *testing.T) {
+		res := s.Validate(map[string]any{
+			"name":  "Mario",
+			"email": "mario@example.com",
+			"age":   float64(30),
+			"address": map[string]any{
+				"city": "Milano",
+			},
+			"password":              "secret",
+			"password_confirmation": "secret",
+		})
+
+		assert.True(t, res.IsValid(), res.Errors())
+	})
+
+	t.Run("should return all errors", func(t *testing.T) {
+		res := s.Validate(map[string]any{
+			"name": "Al",
+			"age":  float64(3),
+			"address": map[string]any{
+				"city": "Reggio Calabria",
+			},
+			"password":              "secret",
+			"password_confirmation": "secret2",
+		})
+
+		assert.Equal(t, 5, res.ErrorCount())
+		assert.False(t, res.IsFieldValid("name"))
+		assert.False(t, res.IsFieldValid("email"))
+		assert.Equal(t, "you are too young", res.FieldErrors("age")[0].Message())
+		assert.Equal(t, "/address/city", res.FieldErrors("address.city")[0].Path().JSONPointer())
+		assert.False(t, res.IsFieldValid("password_confirmation"))
+	})
+
+	t.Run("should not change errors shared between calls", func(t *testing.T) {
+		shared := govalid.NewValidationError("code", "is not allowed")
+		govalid.DefaultRegistry.MustRegister("schema_test_shared", func(govalid.Params) (govalid.ValidationRule, error) {
+			return func(string, any) govalid.ValidationFunc {
+				return func() *internal.ValidationError { return shared }
+			}, nil
+		})
+
+		s, err := schema.Parse([]byte("fields:\n  items.code:\n    - schema_test_shared\n  other:\n    - schema_test_shared:\n      message: custom\n"))
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			res := s.Validate(map[string]any{"items": map[string]any{"code": "x"}, "other": "y"})
+			assert.Equal(t, "/items/code", res.Errors()[0].Path().JSONPointer())
+			assert.Equal(t, "is not allowed", res.Errors()[0].Message())
+			assert.Equal(t, "custom", res.Errors()[1].Message())
+		}
+		assert.Equal(t, "code", shared.Field())
+	})
+
+	t.Run("should report rules failing to build instead of panicking", func(t *testing.T) {
+		govalid.DefaultRegistry.MustRegister("schema_test_positive_field", func(params govalid.Params) (govalid.ValidationRule, error) {
+			if other, _ := params.Lookup(params.Raw()); other != nil && other.(float64) < 0 {
+				return nil, errors.New("negative reference")
+			}
+			return func(string, any) govalid.ValidationFunc { return func() *internal.ValidationError { return nil } }, nil
+		})
+
+		s, err := schema.Parse([]byte("fields:\n  limit: []\n  value: [{schema_test_positive_field: limit}]\n"))
+		require.NoError(t, err)
+
+		res := s.Validate(map[string]any{"limit": float64(-1), "value": "x"})
+		assert.Equal(t, schema.CodeInvalidRule, res.FirstError().Code())
+		assert.Equal(t, "value", res.FirstError().Field())
+	})
+
+	t.Run("should only check required rules on missing values", func(t *testing.T) {
+		res := s.Validate(map[string]any{})
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.False(t, res.IsFieldValid("name"))
+		assert.False(t, res.IsFieldValid("email"))
+	})
+}
+
+func TestValidateStruct(t *testing.T) {
+	s, err := schema.Parse([]byte(jsonSchema))
+	require.NoError(t, err)
+
+	t.Run("should validate structs by json name", func(t *testing.T) {
+		res := s.Validate(&user{
+			Name:    "Al",
+			Email:   "mario@example.com",
+			Age:     30,
+			Address: &address{City: "Reggio Calabria"},
+		})
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.False(t, res.IsFieldValid("name"))
+		assert.False(t, res.IsFieldValid("address.city"))
+	})
+
+	t.Run("should skip nested fields of nil pointers", func(t *testing.T) {
+		res := s.Validate(user{Name: "Mario", Email: "mario@example.com", Age: 30})
+		assert.True(t, res.IsValid())
+	})
+
+	t.Run("should treat referenced fields missing from the struct as nil", func(t *testing.T) {
+		s, err := schema.Parse([]byte("fields:\n  country: []\n  vat:\n    - required_if: country IT\n  end:\n    - gt_field: start\n  start: []\n"))
+		require.NoError(t, err)
+
+		var res govalid.ValidationResult
+		assert.NotPanics(t, func() {
+			res = s.Validate(struct {
+				End int `json:"end"`
+			}{End: 3})
+		})
+		assert.True(t, res.IsValid(), res.Errors())
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{"invalid syntax", "fields:\n  name:\n    - required\n  email:\n\t- email\n", "schema:5: found character that cannot start any token"},
+		{"empty document", "", "schema:1:1: empty schema"},
+		{"not an object", "- a", "schema:1:1: expected an object"},
+		{"missing fields", "other: 1", `schema:1:1: unknown key "other"`},
+		{"fields not an object", "fields: [a]", `schema:1:9: "fields" must be an object`},
+		{"rules not a list", "fields:\n  name: required", `schema:2:9: field "name": expected a list of rules`},
+		{"unknown rule", "fields:\n  name:\n    - required\n    - min_lenn: 3", `schema:4:7: unknown rule "min_lenn"`},
+		{"invalid parameter", "fields:\n  name: [{min_len: three}]", `schema:2:10: rule "min_len": invalid parameter "three"`},
+		{"two rules in an item", "fields:\n  name: [{min_len: 3, max_len: 5}]", `schema:2:23: rule "min_len": only one rule per item`},
+		{"nested parameters", "fields:\n  name: [{min_len: {a: 1}}]", `schema:2:20: rule "min_len": parameters must be a scalar`},
+		{"unknown field in required_if", "fields:\n  country: []\n  vat:\n    - required_if: cuntry IT", `schema:4:7: rule "required_if": invalid parameter "cuntry IT": unknown field cuntry`},
+		{"unknown field in eq_field", "fields:\n  confirm: [{eq_field: password}]", `schema:2:13: rule "eq_field": invalid parameter "password": unknown field password`},
+		{"unknown sibling field", "fields:\n  country: []\n  address.zip: [{required_if: country IT}]", `schema:3:17: rule "required_if"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := schema.Parse([]byte(tc.source))
+
+			var schemaErr *schema.Error
+			require.True(t, errors.As(err, &schemaErr), err)
+			assert.True(t, strings.HasPrefix(err.Error(), tc.expected), err.Error())
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Run("should load a schema file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "user.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(yamlSchema), 0o644))
+
+		s, err := schema.LoadFile(path)
+		require.NoError(t, err)
+		assert.Len(t, s.Fields(), 6)
+	})
+
+	t.Run("should report the file name in errors", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "user.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("fields:\n  name: [unknown]"), 0o644))
+
+		_, err := schema.LoadFile(path)
+		assert.EqualError(t, err, path+`:2:10: unknown rule "unknown"`)
+	})
+
+	t.Run("should load from a reader", func(t *testing.T) {
+		s, err := schema.Load(strings.NewReader(jsonSchema))
+		require.NoError(t, err)
+		assert.Len(t, s.Fields(), 6)
+	})
+}
@@ -0,0 +1,101 @@
+package govalid_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+var personSchema = govalid.NewSchema(
+	govalid.Field("name", func(p person) string { return p.Name },
+		validators.NonEmptyRule(),
+		validators.MinLengthRule(3),
+	),
+	govalid.Field("age", func(p person) int { return p.Age },
+		validators.MinRule(18),
+	),
+)
+
+func TestSchemaValidate(t *testing.T) {
+	t.Run("should return no errors for a valid value", func(t *testing.T) {
+		res := personSchema.Validate(person{Name: "Mario", Age: 30})
+		assert.True(t, res.IsValid())
+	})
+
+	t.Run("should return all errors", func(t *testing.T) {
+		res := personSchema.Validate(person{Name: "", Age: 3})
+
+		assert.Equal(t, 3, res.ErrorCount())
+		assert.Len(t, res.FieldErrors("name"), 2)
+		assert.Len(t, res.FieldErrors("age"), 1)
+	})
+
+	t.Run("should be reusable with different values", func(t *testing.T) {
+		assert.False(t, personSchema.Validate(person{Name: "Al", Age: 30}).IsValid())
+		assert.True(t, personSchema.Validate(person{Name: "Alice", Age: 30}).IsValid())
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(age int) {
+				defer wg.Done()
+				res := personSchema.Validate(person{Name: "Mario", Age: age})
+				assert.Equal(t, age < 18, res.HasErrors())
+			}(i)
+		}
+		wg.Wait()
+	})
+
+	t.Run("should list field names in order", func(t *testing.T) {
+		assert.Equal(t, []string{"name", "age"}, personSchema.FieldNames())
+	})
+}
+
+func TestSchemaShortCircuit(t *testing.T) {
+	t.Run("should stop at the first error", func(t *testing.T) {
+		res := personSchema.ValidateShortCircuit(person{Name: "", Age: 3})
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "must not be empty", res.FirstError().Message())
+	})
+
+	t.Run("should stop at the first error of a short circuit field", func(t *testing.T) {
+		schema := govalid.NewSchema(
+			govalid.FieldShortCircuit("name", func(p person) string { return p.Name },
+				validators.NonEmptyRule(),
+				validators.MinLengthRule(3),
+			),
+			govalid.Field("age", func(p person) int { return p.Age },
+				validators.MinRule(18),
+			),
+		)
+
+		res := schema.Validate(person{Name: "", Age: 3})
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.Len(t, res.FieldErrors("name"), 1)
+	})
+}
+
+func TestSchemaBind(t *testing.T) {
+	t.Run("should be usable with Validate and Compose", func(t *testing.T) {
+		res := govalid.Validate(
+			govalid.Compose(
+				personSchema.Bind(person{Name: "", Age: 3}),
+				validators.NonEmpty("city", ""),
+			),
+		)
+
+		assert.Equal(t, 4, res.ErrorCount())
+	})
+}
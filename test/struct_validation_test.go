@@ -0,0 +1,231 @@
+package govalid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Palma99/govalid"
+	_ "github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	Street string `json:"street" validate:"required"`
+	City   string `json:"city" validate:"required,max_len=10"`
+}
+
+type lineItem struct {
+	Sku      string `json:"sku" validate:"required,matches=^[A-Z]{3}$"`
+	Quantity uint   `json:"quantity" validate:"min=1,max=10"`
+}
+
+type order struct {
+	Email    string     `json:"email" validate:"required,email"`
+	Name     string     `validate:"min_len=3"`
+	Tags     []string   `json:"tags" validate:"required"`
+	Address  address    `json:"address"`
+	Billing  *address   `json:"billing"`
+	Shipping *address   `json:"shipping" validate:"required"`
+	Items    []lineItem `json:"items"`
+	Ignored  address    `validate:"-"`
+	internal string
+}
+
+func validOrder() order {
+	return order{
+		Email:    "mario@example.com",
+		Name:     "Mario",
+		Tags:     []string{"new"},
+		Address:  address{Street: "Via Roma", City: "Milano"},
+		Shipping: &address{Street: "Via Roma", City: "Milano"},
+		Items: []lineItem{
+			{Sku: "ABC", Quantity: 1},
+		},
+	}
+}
+
+func TestValidateStruct(t *testing.T) {
+	t.Run("should return no errors for a valid struct", func(t *testing.T) {
+		res := govalid.ValidateStruct(validOrder())
+		assert.True(t, res.IsValid(), res.Errors())
+	})
+
+	t.Run("should accept a pointer to a struct", func(t *testing.T) {
+		o := validOrder()
+		res := govalid.ValidateStruct(&o)
+		assert.True(t, res.IsValid())
+	})
+
+	t.Run("should apply all rules of a field", func(t *testing.T) {
+		o := validOrder()
+		o.Email = ""
+		o.Name = "Al"
+		o.Tags = nil
+
+		res := govalid.ValidateStruct(o)
+
+		assert.Len(t, res.FieldErrors("email"), 2)
+		assert.Equal(t, "must be at least 3 characters", res.FieldErrors("Name")[0].Message())
+		assert.Equal(t, "must not be empty", res.FieldErrors("tags")[0].Message())
+	})
+
+	t.Run("should walk nested structs and pointers", func(t *testing.T) {
+		o := validOrder()
+		o.Address.City = "Reggio Calabria"
+		o.Billing = &address{}
+
+		res := govalid.ValidateStruct(o)
+
+		assert.Equal(t, 3, res.ErrorCount())
+		assert.False(t, res.IsFieldValid("address.city"))
+		assert.False(t, res.IsFieldValid("billing.street"))
+		assert.False(t, res.IsFieldValid("billing.city"))
+	})
+
+	t.Run("should report nil pointers only when required", func(t *testing.T) {
+		o := validOrder()
+		o.Shipping = nil
+
+		res := govalid.ValidateStruct(o)
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "shipping", res.FirstError().Field())
+	})
+
+	t.Run("should walk slices of structs", func(t *testing.T) {
+		o := validOrder()
+		o.Items = append(o.Items, lineItem{Sku: "abc", Quantity: 11})
+
+		res := govalid.ValidateStruct(o)
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.False(t, res.IsFieldValid("items[1].sku"))
+		assert.Equal(t, "must be at most 10", res.FieldErrors("items[1].quantity")[0].Message())
+	})
+
+	t.Run("should validate cyclic values once", func(t *testing.T) {
+		type node struct {
+			Name     string  `json:"name" validate:"required"`
+			Next     *node   `json:"next"`
+			Children []*node `json:"children"`
+		}
+
+		root := &node{}
+		root.Next = root
+		root.Children = []*node{root, {Next: root}}
+
+		var fields []string
+		for _, err := range govalid.ValidateStruct(root).Errors() {
+			fields = append(fields, err.Field())
+		}
+		assert.Equal(t, []string{"name", "children[1].name"}, fields)
+	})
+
+	t.Run("should reject numeric rules on other types", func(t *testing.T) {
+		res := govalid.ValidateStruct(struct {
+			Name string `validate:"min=3"`
+		}{Name: "Mario"})
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, govalid.CodeUnsupportedType, res.FirstError().Code())
+	})
+
+	t.Run("should panic on unknown rules", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.ValidateStruct(struct {
+				Name string `validate:"unknown"`
+			}{})
+		})
+	})
+
+	t.Run("should panic on invalid rule parameters", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.ValidateStruct(struct {
+				Name string `validate:"min_len=three"`
+			}{})
+		})
+	})
+
+	t.Run("should panic if value is not a struct", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.ValidateStruct(1)
+		})
+	})
+}
+
+func TestValidateStructPaths(t *testing.T) {
+	t.Run("should set structured paths on errors", func(t *testing.T) {
+		o := validOrder()
+		o.Items[0].Sku = ""
+
+		res := govalid.ValidateStruct(o)
+
+		assert.Equal(t, "/items/0/sku", res.FirstError().Path().JSONPointer())
+	})
+
+	t.Run("should not split field names containing dots", func(t *testing.T) {
+		res := govalid.ValidateStruct(struct {
+			Name string `json:"first.name" validate:"required"`
+		}{})
+
+		assert.Equal(t, "/first.name", res.FirstError().Path().JSONPointer())
+	})
+}
+
+func TestValidateStructConditionalTags(t *testing.T) {
+	type company struct {
+		Country   string  `json:"country"`
+		VatNumber string  `json:"vat_number" validate:"required_if=country IT"`
+		TaxID     *string `json:"tax_id" validate:"required_unless=Country IT"`
+	}
+
+	taxID := "123"
+
+	t.Run("should require fields depending on other fields", func(t *testing.T) {
+		res := govalid.ValidateStruct(company{Country: "IT"})
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "vat_number", res.FirstError().Field())
+	})
+
+	t.Run("should check nil pointers", func(t *testing.T) {
+		res := govalid.ValidateStruct(company{Country: "DE"})
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "tax_id", res.FirstError().Field())
+
+		assert.True(t, govalid.ValidateStruct(company{Country: "DE", TaxID: &taxID}).IsValid())
+	})
+
+	t.Run("should panic on unknown fields", func(t *testing.T) {
+		assert.Panics(t, func() {
+			govalid.ValidateStruct(struct {
+				Name string `validate:"required_if=Unknown IT"`
+			}{})
+		})
+	})
+}
+
+func TestValidateStructCrossFieldTags(t *testing.T) {
+	type booking struct {
+		Start    time.Time `json:"start"`
+		End      time.Time `json:"end" validate:"gt_field=start"`
+		Password string    `json:"password"`
+		Confirm  string    `json:"confirm" validate:"eq_field=Password"`
+	}
+
+	now := time.Now()
+
+	t.Run("should compare fields", func(t *testing.T) {
+		res := govalid.ValidateStruct(booking{Start: now, End: now, Password: "a", Confirm: "b"})
+
+		assert.Equal(t, 2, res.ErrorCount())
+		assert.Equal(t, "must be greater than start", res.FieldErrors("end")[0].Message())
+		assert.Equal(t, "must be equal to Password", res.FieldErrors("confirm")[0].Message())
+	})
+
+	t.Run("should return no errors for valid values", func(t *testing.T) {
+		res := govalid.ValidateStruct(booking{Start: now, End: now.Add(time.Hour), Password: "a", Confirm: "a"})
+		assert.True(t, res.IsValid())
+	})
+}
@@ -0,0 +1,184 @@
+package govalid_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+type shippingAddress struct {
+	City string `json:"city"`
+}
+
+func (a shippingAddress) Validate() govalid.ValidationResult {
+	return govalid.Validate(validators.NonEmpty("city", a.City))
+}
+
+type orderLine struct {
+	Sku string
+}
+
+// Pointer receiver, found on addressable values only
+func (l *orderLine) Validate() govalid.ValidationResult {
+	return govalid.Validate(validators.MinLength("sku", l.Sku, 3))
+}
+
+type orderDetails struct {
+	Lines []orderLine `json:"lines"`
+}
+
+type customerOrder struct {
+	Email     string                     `json:"email"`
+	Shipping  shippingAddress            `json:"shipping"`
+	Billing   *shippingAddress           `json:"billing"`
+	Details   orderDetails               `json:"details"`
+	Addresses map[string]shippingAddress `json:"addresses"`
+	Any       any                        `json:"any"`
+	Skipped   shippingAddress            `json:"skipped" validate:"-"`
+	Tags      []string                   `json:"tags"`
+}
+
+func (o customerOrder) Validate() govalid.ValidationResult {
+	return govalid.Validate(validators.IsEmail("email", o.Email))
+}
+
+type treeNode struct {
+	Name     string      `json:"name"`
+	Parent   *treeNode   `json:"parent"`
+	Children []*treeNode `json:"children"`
+}
+
+func (n *treeNode) Validate() govalid.ValidationResult {
+	return govalid.Validate(validators.NonEmpty("name", n.Name))
+}
+
+// Exported so it can be embedded
+type PostalAddress struct {
+	City  string      `json:"city"`
+	Lines []orderLine `json:"lines"`
+}
+
+func (a PostalAddress) Validate() govalid.ValidationResult {
+	return govalid.Validate(validators.NonEmpty("city", a.City))
+}
+
+// Validatable through the promoted PostalAddress method
+type embeddingOrder struct {
+	PostalAddress
+	Email string
+}
+
+func TestValidatable(t *testing.T) {
+	invalidOrder := customerOrder{
+		Email:    "mario",
+		Shipping: shippingAddress{},
+		Billing:  &shippingAddress{},
+		Details:  orderDetails{Lines: []orderLine{{Sku: "ABC"}, {Sku: "A"}}},
+		Addresses: map[string]shippingAddress{
+			"work": {},
+			"home": {City: "Rome"},
+			"gym":  {},
+		},
+		Any:     shippingAddress{},
+		Skipped: shippingAddress{},
+		Tags:    []string{"a", "b"},
+	}
+
+	t.Run("Should accept Validatable values", func(t *testing.T) {
+		res := govalid.Validate(shippingAddress{City: "Rome"}, validators.NonEmpty("name", ""))
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "name", res.Errors()[0].Field())
+	})
+
+	t.Run("Should discover nested Validatable values with their path", func(t *testing.T) {
+		res := govalid.Validate(invalidOrder)
+
+		var fields []string
+		for _, err := range res.Errors() {
+			fields = append(fields, err.Field())
+		}
+		assert.Equal(t, []string{
+			"email",
+			"shipping.city",
+			"billing.city",
+			"details.lines[1].sku",
+			"addresses[gym].city",
+			"addresses[work].city",
+			"any.city",
+		}, fields)
+
+		assert.Equal(t, "/details/lines/1/sku", res.FieldErrors("details.lines[1].sku")[0].Path().JSONPointer())
+	})
+
+	t.Run("Should find pointer receiver methods through pointers", func(t *testing.T) {
+		order := &customerOrder{
+			Email:    "mario@example.com",
+			Shipping: shippingAddress{City: "Rome"},
+			Details:  orderDetails{Lines: []orderLine{{Sku: "A"}}},
+		}
+
+		res := govalid.Validate(order)
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "details.lines[0].sku", res.Errors()[0].Field())
+	})
+
+	t.Run("Should stop at the first error in short circuit mode", func(t *testing.T) {
+		res := govalid.ValidateShortCircuit(validators.NonEmpty("name", "Mario"), invalidOrder)
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "email", res.Errors()[0].Field())
+	})
+
+	t.Run("Should not loop on cycles", func(t *testing.T) {
+		root := &treeNode{Name: "root"}
+		child := &treeNode{Parent: root}
+		root.Children = []*treeNode{child, {Name: "other", Parent: root}}
+
+		res := govalid.Validate(root)
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "children[0].name", res.Errors()[0].Field())
+	})
+
+	t.Run("Should validate embedded values once", func(t *testing.T) {
+		res := govalid.Validate(embeddingOrder{PostalAddress: PostalAddress{Lines: []orderLine{{Sku: "A"}}}})
+
+		var fields []string
+		for _, err := range res.Errors() {
+			fields = append(fields, err.Field())
+		}
+		assert.Equal(t, []string{"city", "PostalAddress.lines[0].sku"}, fields)
+	})
+
+	t.Run("Should be accepted by context and parallel validations", func(t *testing.T) {
+		expected := govalid.Validate(invalidOrder).Errors()
+
+		res, err := govalid.ValidateCtx(context.Background(), invalidOrder)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res.Errors())
+
+		res = govalid.ValidateParallel(govalid.ParallelOptions{}, invalidOrder)
+		assert.Equal(t, expected, res.Errors())
+
+		res, err = govalid.ValidateShortCircuitCtx(context.Background(), invalidOrder)
+		assert.NoError(t, err)
+		assert.Equal(t, expected[:1], res.Errors())
+	})
+
+	t.Run("Should return the first error when short circuit composed", func(t *testing.T) {
+		res := govalid.Validate(govalid.ComposeShortCircuit(invalidOrder))
+
+		assert.Equal(t, 1, res.ErrorCount())
+		assert.Equal(t, "email", res.Errors()[0].Field())
+	})
+
+	t.Run("Should still panic on unsupported types", func(t *testing.T) {
+		assert.Panics(t, func() { govalid.Validate("not a validation") })
+		assert.Panics(t, func() { govalid.Compose(invalidOrder) })
+		assert.Panics(t, func() { govalid.Nest("order", invalidOrder) })
+	})
+}
@@ -1,9 +1,11 @@
 package govalid_test
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
+	"github.com/Palma99/govalid"
 	"github.com/Palma99/govalid/internal"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,3 +36,105 @@ func TestValidationError_ErrorMethod(t *testing.T) {
 	expectedMsg := "email: invalid format"
 	assert.Equal(t, expectedMsg, e.Error())
 }
+
+func TestValidationErrorDetails(t *testing.T) {
+	t.Run("should have no details by default", func(t *testing.T) {
+		err := internal.NewValidationError("name", "required")
+
+		assert.Empty(t, err.Code())
+		assert.Empty(t, err.Params())
+		assert.Nil(t, err.Value())
+	})
+
+	t.Run("should set code, params and value", func(t *testing.T) {
+		err := internal.NewValidationError("name", "too short").
+			WithCode("min_length").
+			WithParam("min", 3).
+			WithValue("Al")
+
+		assert.Equal(t, "min_length", err.Code())
+		assert.Equal(t, map[string]any{"min": 3}, err.Params())
+		assert.Equal(t, "Al", err.Value())
+
+		min, ok := err.Param("min")
+		assert.True(t, ok)
+		assert.Equal(t, 3, min)
+	})
+
+	t.Run("should not expose the params map", func(t *testing.T) {
+		err := internal.NewValidationError("name", "too short").WithParam("min", 3)
+
+		err.Params()["min"] = 4
+
+		min, _ := err.Param("min")
+		assert.Equal(t, 3, min)
+	})
+}
+
+func TestValidationErrorJSON(t *testing.T) {
+	t.Run("should round trip", func(t *testing.T) {
+		err := internal.NewValidationError("name", "too short").
+			WithCode("min_length").
+			WithParam("min", 3).
+			WithValue("Al").
+			WithCustomMessage("troppo corto")
+
+		data, marshalErr := json.Marshal(err)
+		assert.NoError(t, marshalErr)
+		assert.JSONEq(t, `{
+			"field": "name",
+			"message": "troppo corto",
+			"code": "min_length",
+			"params": {"min": 3},
+			"value": "Al",
+			"custom_message": true
+		}`, string(data))
+
+		var decoded internal.ValidationError
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, "name", decoded.Field())
+		assert.Equal(t, "troppo corto", decoded.Message())
+		assert.Equal(t, "min_length", decoded.Code())
+		assert.Equal(t, map[string]any{"min": float64(3)}, decoded.Params())
+		assert.Equal(t, "Al", decoded.Value())
+		assert.True(t, decoded.HasCustomMessage())
+	})
+
+	t.Run("should keep map keys with dots and brackets when decoding", func(t *testing.T) {
+		path := govalid.NewPath(govalid.FieldSegment("labels"), govalid.KeySegment("a.b]"))
+		data, err := json.Marshal(internal.NewValidationError("", "invalid").WithPath(path))
+		assert.NoError(t, err)
+
+		var decoded internal.ValidationError
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, path, decoded.Path())
+		assert.Equal(t, "/labels/a.b]", decoded.Path().JSONPointer())
+	})
+
+	t.Run("should keep numeric map keys and plain names when decoding", func(t *testing.T) {
+		keyed := internal.NewValidationError("", "invalid").
+			WithPath(govalid.NewPath(govalid.FieldSegment("labels"), govalid.KeySegment("2")))
+
+		for _, original := range []*internal.ValidationError{keyed, internal.NewValidationError(`C:\dir`, "invalid")} {
+			data, err := json.Marshal(original)
+			assert.NoError(t, err)
+
+			var decoded internal.ValidationError
+			assert.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, original.Path(), decoded.Path())
+			assert.Equal(t, original.Field(), decoded.Field())
+		}
+	})
+
+	t.Run("should omit empty details", func(t *testing.T) {
+		data, err := json.Marshal(internal.NewValidationError("name", "required"))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"field": "name", "message": "required"}`, string(data))
+	})
+
+	t.Run("should encode values not supported by encoding/json as strings", func(t *testing.T) {
+		data, err := json.Marshal(internal.NewValidationError("fn", "invalid").WithValue(func() {}))
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"value":"0x`)
+	})
+}
@@ -1,6 +1,7 @@
 package govalid_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Palma99/govalid"
@@ -128,3 +129,29 @@ func TestIsFieldValid(t *testing.T) {
 		assert.True(t, res.IsFieldValid("surname"))
 	})
 }
+
+func TestValidationResultJSON(t *testing.T) {
+	t.Run("should round trip", func(t *testing.T) {
+		res := govalid.NewValidationResult(
+			*internal.NewValidationError("name", "must not be empty").WithCode("non_empty"),
+			*internal.NewValidationError("age", "must be at least 18").WithCode("min").WithParam("min", 18),
+		)
+
+		data, err := json.Marshal(res)
+		assert.NoError(t, err)
+
+		var decoded govalid.ValidationResult
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+
+		assert.Equal(t, 2, decoded.ErrorCount())
+		assert.Equal(t, "name", decoded.Errors()[0].Field())
+		assert.Equal(t, "non_empty", decoded.Errors()[0].Code())
+		assert.Equal(t, map[string]any{"min": float64(18)}, decoded.Errors()[1].Params())
+	})
+
+	t.Run("should encode a valid result with an empty errors array", func(t *testing.T) {
+		data, err := json.Marshal(govalid.NewValidationResult())
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"errors": []}`, string(data))
+	})
+}
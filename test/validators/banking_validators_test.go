@@ -0,0 +1,117 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsIBAN(t *testing.T) {
+	t.Run("should accept valid IBANs", func(t *testing.T) {
+		for _, value := range []string{
+			"DE89370400440532013000",
+			"GB82WEST12345698765432",
+			"IT60X0542811101000000123456",
+			"IT60 X054 2811 1010 0000 0123 456",
+			"it60x0542811101000000123456",
+			"FR1420041010050500013M02606",
+			"NL91ABNA0417164300",
+			"BE68539007547034",
+			"CH9300762011623852957",
+			"NO9386011117947",
+			"MT84MALT011000012345MTLCAST001S",
+			"BR1800360305000010009795493C1",
+			"MU17BOMM0101101030300200000MUR",
+		} {
+			assert.Nil(t, validators.IsIBAN("iban", value, nil)(), value)
+		}
+	})
+
+	t.Run("should tell which part failed", func(t *testing.T) {
+		tests := []struct {
+			value string
+			code  string
+		}{
+			{"", validators.CodeIBANCountry},
+			{"US12345678901234567890", validators.CodeIBANCountry},
+			{"DE8937040044053201300", validators.CodeIBANLength},
+			{"DE89370400440532013000 00", validators.CodeIBANLength},
+			{"DE8937040044053201300A", validators.CodeIBAN},
+			{"DEXX370400440532013000", validators.CodeIBAN},
+			{"GB82WE5T12345698765432", validators.CodeIBAN},
+			{"DE88370400440532013000", validators.CodeCheckDigit},
+			{"GB82WEST12345698765433", validators.CodeCheckDigit},
+		}
+
+		for _, tc := range tests {
+			err := validators.IsIBAN("iban", tc.value, nil)()
+			if assert.NotNil(t, err, tc.value) {
+				assert.Equal(t, tc.code, err.Code(), tc.value)
+			}
+		}
+
+		err := validators.IsIBAN("iban", "DE8937040044053201300", nil)()
+		assert.Equal(t, "must be 22 characters long for DE", err.Message())
+		assert.Equal(t, map[string]any{"length": 22, "country": "DE"}, err.Params())
+	})
+
+	t.Run("should restrict the countries", func(t *testing.T) {
+		assert.Nil(t, validators.IsIBAN("iban", "CH9300762011623852957", validators.SEPACountries)())
+
+		err := validators.IsIBAN("iban", "BR1800360305000010009795493C1", validators.SEPACountries)()
+		assert.Equal(t, validators.CodeIBANCountry, err.Code())
+
+		err = validators.IsIBAN("iban", "DE89370400440532013000", []string{"IT", "SM"})()
+		assert.Equal(t, "must be an IBAN of one of the countries IT, SM", err.Message())
+	})
+}
+
+func TestIsBIC(t *testing.T) {
+	for _, value := range []string{"UNCRITMM", "UNCRITMMXXX", "deutdeff500", "BOFAUS3N"} {
+		assert.Nil(t, validators.IsBIC("bic", value, nil)(), value)
+	}
+
+	for _, value := range []string{"", "UNCRITM", "UNCRITMMXX", "UNC1ITMM", "UNCRIT_M", "UNCRITMMXXXX"} {
+		err := validators.IsBIC("bic", value, nil)()
+		if assert.NotNil(t, err, value) {
+			assert.Equal(t, validators.CodeBIC, err.Code(), value)
+		}
+	}
+
+	err := validators.IsBIC("bic", "BOFAUS3N", validators.SEPACountries)()
+	assert.Equal(t, validators.CodeBICCountry, err.Code())
+}
+
+func TestBankingRules(t *testing.T) {
+	t.Run("should be usable in groups", func(t *testing.T) {
+		result := govalid.Validate(
+			govalid.Group("iban", "DE88370400440532013000", validators.IsIBANRule(nil)),
+			govalid.Group("bic", "UNCRITMM", validators.IsBICRule([]string{"DE"}, "solo banche tedesche")),
+		)
+
+		assert.Equal(t, 2, result.ErrorCount())
+		assert.Equal(t, validators.CodeCheckDigit, result.Errors()[0].Code())
+		assert.Equal(t, "solo banche tedesche", result.Errors()[1].Message())
+	})
+
+	t.Run("should be available as struct tags", func(t *testing.T) {
+		type bankAccount struct {
+			IBAN string `validate:"iban=sepa"`
+			BIC  string `validate:"bic=IT,SM"`
+		}
+
+		assert.True(t, govalid.ValidateStruct(bankAccount{IBAN: "IT60X0542811101000000123456", BIC: "UNCRITMM"}).IsValid())
+
+		result := govalid.ValidateStruct(bankAccount{IBAN: "BR1800360305000010009795493C1", BIC: "DEUTDEFF"})
+		assert.Equal(t, 2, result.ErrorCount())
+		assert.Equal(t, validators.CodeIBANCountry, result.Errors()[0].Code())
+		assert.Equal(t, validators.CodeBICCountry, result.Errors()[1].Code())
+	})
+
+	t.Run("should be translated", func(t *testing.T) {
+		result := govalid.Validate(validators.IsIBAN("iban", "IT60X054281110100000012345", nil)).Localize("it")
+		assert.Equal(t, "deve contenere 27 caratteri per IT", result.Errors()[0].Message())
+	})
+}
@@ -1,10 +1,13 @@
 package validators_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/Palma99/govalid"
 	"github.com/Palma99/govalid/validators"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNonEmptyRule(t *testing.T) {
@@ -86,3 +89,66 @@ func TestMinRule(t *testing.T) {
 	validator = rule("code", 5)
 	assert.Nil(t, validator())
 }
+
+func TestRulesWithUnsupportedTypes(t *testing.T) {
+	rules := map[string]govalid.ValidationRule{
+		"MinLengthRule":    validators.MinLengthRule(3),
+		"MaxLengthRule":    validators.MaxLengthRule(3),
+		"MatchesRegexRule": validators.MatchesRegexRule(`^[a-z]+$`),
+		"IsEmailRule":      validators.IsEmailRule(),
+	}
+
+	for name, rule := range rules {
+		t.Run(name+" should not panic", func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				err := rule("field", 42)()
+				assert.NotNil(t, err)
+				assert.Equal(t, validators.CodeUnsupportedType, err.Code())
+			})
+		})
+	}
+
+	numericRules := map[string]govalid.ValidationRule{
+		"MinRule": validators.MinRule(5),
+		"MaxRule": validators.MaxRule(5),
+	}
+
+	for name, rule := range numericRules {
+		t.Run(name+" should reject other types", func(t *testing.T) {
+			for _, value := range []any{"abc", int64(1), nil} {
+				err := rule("field", value)()
+				require.NotNil(t, err, "%T", value)
+				assert.Equal(t, validators.CodeUnsupportedType, err.Code())
+				assert.Equal(t, fmt.Sprintf("unsupported type %T, expected int", value), err.Message())
+			}
+		})
+	}
+
+	t.Run("should use the same message for every rule", func(t *testing.T) {
+		assert.Equal(t, "unsupported type int, expected string, slice, array or map", validators.MinLengthRule(3)("field", 42)().Message())
+		assert.Equal(t, "unsupported type int, expected string", validators.IsEmailRule()("field", 42)().Message())
+		assert.Equal(t, map[string]any{"expected": "string"}, validators.IsEmailRule()("field", 42)().Params())
+	})
+
+	t.Run("MinLengthRule should work with slices", func(t *testing.T) {
+		assert.NotNil(t, validators.MinLengthRule(2)("tags", []string{"a"})())
+	})
+}
+
+func TestRequiredIf(t *testing.T) {
+	yes := func() bool { return true }
+	no := func() bool { return false }
+
+	assert.NotNil(t, validators.RequiredIf(yes)("vat_number", "")())
+	assert.Nil(t, validators.RequiredIf(yes)("vat_number", "123")())
+	assert.Nil(t, validators.RequiredIf(no)("vat_number", "")())
+	assert.Equal(t, "obbligatoria", validators.RequiredIf(yes, "obbligatoria")("vat_number", "")().Message())
+}
+
+func TestRequiredUnless(t *testing.T) {
+	yes := func() bool { return true }
+	no := func() bool { return false }
+
+	assert.NotNil(t, validators.RequiredUnless(no)("tax_id", "")())
+	assert.Nil(t, validators.RequiredUnless(yes)("tax_id", "")())
+}
@@ -3,6 +3,7 @@ package validators_test
 import (
 	"testing"
 
+	"github.com/Palma99/govalid"
 	"github.com/Palma99/govalid/validators"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,6 +21,24 @@ func TestNonEmptyValidator(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("should return error when nil", func(t *testing.T) {
+		err := validators.NonEmpty("name", nil)()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should support typed slices and named strings", func(t *testing.T) {
+		type name string
+
+		assert.NotNil(t, validators.NonEmpty("tags", []string{})())
+		assert.Nil(t, validators.NonEmpty("tags", []string{"a"})())
+		assert.NotNil(t, validators.NonEmpty("name", name(" "))())
+	})
+
+	t.Run("should pass zero numbers", func(t *testing.T) {
+		assert.Nil(t, validators.NonEmpty("age", 0)())
+		assert.Nil(t, validators.NonEmptyRule()("age", 0)())
+	})
+
 	t.Run("should support custom error message", func(t *testing.T) {
 		err := validators.NonEmpty("name", "", "campo obbligatorio")()
 		assert.NotNil(t, err)
@@ -68,3 +87,93 @@ func TestIsEmailValidator(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestValidatorsErrorDetails(t *testing.T) {
+	type testCase struct {
+		name   string
+		err    govalid.ValidationFunc
+		code   string
+		params map[string]any
+		value  any
+	}
+
+	testCases := []testCase{
+		{
+			name:  "NonEmpty",
+			err:   validators.NonEmpty("name", ""),
+			code:  validators.CodeNonEmpty,
+			value: "",
+		},
+		{
+			name:   "Min",
+			err:    validators.Min("age", 3, 18),
+			code:   validators.CodeMin,
+			params: map[string]any{"min": 18},
+			value:  3,
+		},
+		{
+			name:   "Max",
+			err:    validators.Max("age", 130, 120),
+			code:   validators.CodeMax,
+			params: map[string]any{"max": 120},
+			value:  130,
+		},
+		{
+			name:   "MinLength",
+			err:    validators.MinLength("name", "Al", 3),
+			code:   validators.CodeMinLength,
+			params: map[string]any{"min": 3},
+			value:  "Al",
+		},
+		{
+			name:   "MaxLength",
+			err:    validators.MaxLength("name", "Mario", 3),
+			code:   validators.CodeMaxLength,
+			params: map[string]any{"max": 3},
+			value:  "Mario",
+		},
+		{
+			name:  "MinLength with unsupported type",
+			err:   validators.MinLength("name", 1, 3),
+			code:  validators.CodeUnsupportedType,
+			value: 1,
+		},
+		{
+			name:   "MatchesRegex",
+			err:    validators.MatchesRegex("code", "123", `^[A-Z]+$`),
+			code:   validators.CodeMatchesRegex,
+			params: map[string]any{"pattern": `^[A-Z]+$`},
+			value:  "123",
+		},
+		{
+			name:  "CustomValidator",
+			err:   validators.CustomValidator(func(int) *string { msg := "invalid"; return &msg })("n", 1),
+			code:  validators.CodeCustom,
+			value: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.err()
+			assert.NotNil(t, err)
+			assert.Equal(t, tc.code, err.Code())
+			assert.Equal(t, tc.value, err.Value())
+			if tc.params != nil {
+				assert.Equal(t, tc.params, err.Params())
+			}
+		})
+	}
+
+	t.Run("IsEmail", func(t *testing.T) {
+		err := validators.IsEmail("email", "not-an-email")()
+		assert.Equal(t, validators.CodeEmail, err.Code())
+		assert.Equal(t, "not-an-email", err.Value())
+	})
+
+	t.Run("should keep details with a custom message", func(t *testing.T) {
+		err := validators.MinLength("name", "Al", 3, "troppo corto")()
+		assert.Equal(t, "troppo corto", err.Message())
+		assert.Equal(t, validators.CodeMinLength, err.Code())
+	})
+}
@@ -0,0 +1,131 @@
+package validators_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualTo(t *testing.T) {
+	t.Run("should return nil for equal values", func(t *testing.T) {
+		assert.Nil(t, validators.EqualTo("password_confirmation", "secret", "password", "secret")())
+	})
+
+	t.Run("should reference both fields", func(t *testing.T) {
+		err := validators.EqualTo("password_confirmation", "secret1", "password", "secret")()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "password_confirmation", err.Field())
+		assert.Equal(t, "must be equal to password", err.Message())
+		assert.Equal(t, validators.CodeEqualToField, err.Code())
+		assert.Equal(t, map[string]any{"other_field": "password"}, err.Params())
+	})
+
+	t.Run("should support custom messages", func(t *testing.T) {
+		err := validators.EqualTo("a", 1, "b", 2, "le password non coincidono")()
+		assert.Equal(t, "le password non coincidono", err.Message())
+	})
+}
+
+func TestFieldComparisons(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	type testCase struct {
+		name       string
+		validation govalid.ValidationFunc
+		valid      bool
+	}
+
+	testCases := []testCase{
+		{"NotEqualTo with different values", validators.NotEqualTo("new", "a", "old", "b"), true},
+		{"NotEqualTo with equal values", validators.NotEqualTo("new", "a", "old", "a"), false},
+		{"GreaterThanField with times", validators.GreaterThanField("end", end, "start", start), true},
+		{"GreaterThanField with equal times", validators.GreaterThanField("end", start, "start", start), false},
+		{"GreaterThanOrEqualField with times in other locations", validators.GreaterThanOrEqualField("end", start.In(time.FixedZone("CET", 3600)), "start", start), true},
+		{"GreaterThanOrEqualField with ints", validators.GreaterThanOrEqualField("max", 10, "min", 10), true},
+		{"LessThanField with floats", validators.LessThanField("min_price", 9.99, "max_price", 10.0), true},
+		{"LessThanField with strings", validators.LessThanField("from", "b", "to", "a"), false},
+		{"LessThanOrEqualField with different int types", validators.LessThanOrEqualField("min", int8(3), "max", int64(3)), true},
+		{"LessThanOrEqualField with uints", validators.LessThanOrEqualField("min", uint(4), "max", uint(3)), false},
+		{"LessThanOrEqualField with ints and floats", validators.LessThanOrEqualField("min", 3, "max", 2.5), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.validation()
+			if tc.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+
+	t.Run("should return an unsupported type error for values that can not be compared", func(t *testing.T) {
+		err := validators.GreaterThanField("end", "tomorrow", "start", start)()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, validators.CodeUnsupportedType, err.Code())
+		assert.Equal(t, "can not be compared with start", err.Message())
+	})
+}
+
+func TestFieldComparisonsWithNil(t *testing.T) {
+	t.Run("should skip the comparison if either value is nil", func(t *testing.T) {
+		var unset *time.Time
+		now := time.Now()
+
+		assert.Nil(t, validators.GreaterThanField("end", now, "start", nil)())
+		assert.Nil(t, validators.GreaterThanField("end", now, "start", unset)())
+		assert.Nil(t, validators.GreaterThanField("end", unset, "start", now)())
+	})
+
+	t.Run("should compare the values of pointers", func(t *testing.T) {
+		start := time.Now()
+		end := start.Add(-time.Hour)
+
+		err := validators.GreaterThanField("end", &end, "start", &start)()
+		assert.Equal(t, validators.CodeGreaterThanField, err.Code())
+	})
+
+	t.Run("should skip nil optional fields in struct tags", func(t *testing.T) {
+		type booking struct {
+			Start *time.Time `json:"start"`
+			End   time.Time  `json:"end" validate:"gt_field=Start"`
+		}
+
+		assert.True(t, govalid.ValidateStruct(booking{End: time.Now()}).IsValid())
+	})
+}
+
+func TestCrossFieldRules(t *testing.T) {
+	form := struct {
+		Password             string
+		PasswordConfirmation string
+		MinPrice             int
+		MaxPrice             int
+	}{"secret", "secret2", 10, 5}
+
+	res := govalid.Validate(
+		govalid.Group("password_confirmation", form.PasswordConfirmation,
+			validators.EqualToRule("password", form.Password),
+		),
+		govalid.Group("max_price", form.MaxPrice,
+			validators.GreaterThanFieldRule("min_price", form.MinPrice),
+			validators.GreaterThanOrEqualFieldRule("min_price", form.MinPrice),
+			validators.NotEqualToRule("min_price", form.MinPrice),
+		),
+		govalid.Group("min_price", form.MinPrice,
+			validators.LessThanFieldRule("max_price", form.MaxPrice),
+			validators.LessThanOrEqualFieldRule("max_price", form.MaxPrice),
+		),
+	)
+
+	assert.Equal(t, 5, res.ErrorCount())
+	assert.Len(t, res.FieldErrors("max_price"), 2)
+	assert.Equal(t, "must be less than or equal to max_price", res.Errors()[4].Message())
+}
@@ -0,0 +1,130 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCodiceFiscale(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string // Empty for valid values
+	}{
+		{"RSSMRA85T10A562S", ""},
+		{"rssmra85t10a562s ", ""},
+		{"RSSMRA85T10A56NH", ""}, // Omocodia
+		{"00743110157", ""},      // Numeric code of a company
+		{"RSSMRA85T10A562", validators.CodeCodiceFiscale},
+		{"RSSMR485T10A562S", validators.CodeCodiceFiscale},
+		{"RSSMRA85T10A5A2S", validators.CodeCodiceFiscale},
+		{"RSSMRA85Z10A562S", validators.CodeCodiceFiscaleBirthDate},
+		{"RSSMRA85T32A562S", validators.CodeCodiceFiscaleBirthDate},
+		{"RSSMRA85B30A562S", validators.CodeCodiceFiscaleBirthDate},
+		{"RSSMRA85T10A562T", validators.CodeCheckDigit},
+		{"00743110158", validators.CodeCheckDigit},
+	}
+
+	for _, tc := range tests {
+		err := validators.IsCodiceFiscale("codice_fiscale", tc.value)()
+		if tc.code == "" {
+			assert.Nil(t, err, tc.value)
+			continue
+		}
+		if assert.NotNil(t, err, tc.value) {
+			assert.Equal(t, tc.code, err.Code(), tc.value)
+		}
+	}
+
+	err := validators.IsCodiceFiscale("codice_fiscale", "RSSMRA85T10A562T")()
+	assert.Equal(t, "has an invalid codice fiscale check digit", err.Message())
+}
+
+func TestIsPartitaIVA(t *testing.T) {
+	assert.Nil(t, validators.IsPartitaIVA("vat", "00743110157")())
+	assert.Nil(t, validators.IsPartitaIVA("vat", "IT 00743110157")())
+
+	assert.Equal(t, validators.CodePartitaIVA, validators.IsPartitaIVA("vat", "0074311015")().Code())
+	assert.Equal(t, validators.CodeCheckDigit, validators.IsPartitaIVA("vat", "00743110158")().Code())
+
+	err := validators.IsPartitaIVA("vat", "00743112007")()
+	assert.Equal(t, validators.CodePartitaIVAOffice, err.Code())
+	assert.Equal(t, map[string]any{"office": "200"}, err.Params())
+}
+
+func TestIsEUVAT(t *testing.T) {
+	t.Run("should accept valid numbers of every member state", func(t *testing.T) {
+		for _, value := range []string{
+			"ATU13585627", "BE0403019261", "BG175074752", "CY10259033P", "CZ25123891",
+			"DE136695976", "DK13585628", "EE100931558", "EL094259216", "ESA13585625",
+			"ES54362315K", "FI20774740", "FR40303265045", "HR33392005961", "HU12892312",
+			"IE6433435F", "IE8D79739I", "IT00743110157", "LT119511515", "LU15027442",
+			"LV40003521600", "MT11679112", "NL004495445B01", "PL8567346215", "PT501964843",
+			"RO18547290", "SE123456789701", "SI50223054", "SK2022749619",
+			"de 136.695.976",
+		} {
+			assert.Nil(t, validators.IsEUVAT("vat", value, nil)(), value)
+		}
+	})
+
+	t.Run("should tell which part failed", func(t *testing.T) {
+		err := validators.IsEUVAT("vat", "DE136695977", nil)()
+		assert.Equal(t, validators.CodeCheckDigit, err.Code())
+		assert.Equal(t, map[string]any{"identifier": "DE VAT number"}, err.Params())
+
+		err = validators.IsEUVAT("vat", "DE036695976", nil)()
+		assert.Equal(t, validators.CodeVAT, err.Code())
+		assert.Equal(t, "must be a valid DE VAT number", err.Message())
+		assert.Equal(t, map[string]any{"country": "DE"}, err.Params())
+
+		for _, value := range []string{"", "X", "GB123456789", "136695976"} {
+			err := validators.IsEUVAT("vat", value, nil)()
+			if assert.NotNil(t, err, value) {
+				assert.Equal(t, validators.CodeVATCountry, err.Code(), value)
+			}
+		}
+	})
+
+	t.Run("should restrict the countries", func(t *testing.T) {
+		countries := []string{"it", "FR"}
+		assert.Nil(t, validators.IsEUVAT("vat", "IT00743110157", countries)())
+
+		err := validators.IsEUVAT("vat", "DE136695976", countries)()
+		assert.Equal(t, validators.CodeVATCountry, err.Code())
+		assert.Equal(t, "must start with one of the country codes it, FR", err.Message())
+	})
+}
+
+func TestFiscalRules(t *testing.T) {
+	t.Run("should be usable in groups", func(t *testing.T) {
+		result := govalid.Validate(
+			govalid.Group("codice_fiscale", "RSSMRA85T10A562T", validators.IsCodiceFiscaleRule()),
+			govalid.Group("partita_iva", "00743110157", validators.IsPartitaIVARule()),
+			govalid.Group("vat", "FR40303265045", validators.IsEUVATRule([]string{"IT"}, "solo partite IVA italiane")),
+		)
+
+		assert.Equal(t, 2, result.ErrorCount())
+		assert.Equal(t, validators.CodeCheckDigit, result.Errors()[0].Code())
+		assert.Equal(t, "solo partite IVA italiane", result.Errors()[1].Message())
+	})
+
+	t.Run("should be available as struct tags", func(t *testing.T) {
+		type company struct {
+			CodiceFiscale string `validate:"codice_fiscale"`
+			PartitaIVA    string `validate:"partita_iva"`
+			VAT           string `validate:"eu_vat=IT,DE"`
+		}
+
+		result := govalid.ValidateStruct(company{CodiceFiscale: "00743110157", PartitaIVA: "00743110157", VAT: "ATU13585627"})
+
+		assert.Equal(t, 1, result.ErrorCount())
+		assert.Equal(t, validators.CodeVATCountry, result.Errors()[0].Code())
+	})
+
+	t.Run("should be translated", func(t *testing.T) {
+		result := govalid.Validate(validators.IsCodiceFiscale("cf", "RSSMRA85T32A562S")).Localize("it")
+		assert.Equal(t, "ha una data di nascita non valida", result.Errors()[0].Message())
+	})
+}
@@ -0,0 +1,203 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifierValidators(t *testing.T) {
+	type testCase struct {
+		value string
+		code  string // Empty for valid values
+	}
+
+	validatorTests := []struct {
+		name      string
+		validator func(field, value string, args ...string) govalid.ValidationFunc
+		cases     []testCase
+	}{
+		{"IsULID", validators.IsULID, []testCase{
+			{"01ARZ3NDEKTSV4RRFFQ69G5FAV", ""},
+			{"01arz3ndektsv4rrffq69g5fav", ""},
+			{"81ARZ3NDEKTSV4RRFFQ69G5FAV", validators.CodeULID},
+			{"01ARZ3NDEKTSV4RRFFQ69G5FAI", validators.CodeULID},
+			{"01ARZ3NDEKTSV4RRFFQ69G5FA", validators.CodeULID},
+		}},
+		{"IsISBN", validators.IsISBN, []testCase{
+			{"978-0-306-40615-7", ""},
+			{"0-306-40615-2", ""},
+			{"978-0-306-40615-8", validators.CodeCheckDigit},
+			{"0-306-40615-3", validators.CodeCheckDigit},
+			{"978-0-306-4061", validators.CodeISBN},
+		}},
+		{"IsISBN10", validators.IsISBN10, []testCase{
+			{"0306406152", ""},
+			{"0-8044-2957-X", ""},
+			{"0-8044-2957-x", ""},
+			{"0-8044-2957-1", validators.CodeCheckDigit},
+			{"X306406152", validators.CodeISBN},
+			{"9780306406157", validators.CodeISBN},
+		}},
+		{"IsISBN13", validators.IsISBN13, []testCase{
+			{"9780306406157", ""},
+			{"978 0 306 40615 7", ""},
+			{"9770306406157", validators.CodeISBN},
+			{"9780306406150", validators.CodeCheckDigit},
+		}},
+		{"IsEAN8", validators.IsEAN8, []testCase{
+			{"96385074", ""},
+			{"96385075", validators.CodeCheckDigit},
+			{"9638507", validators.CodeEAN8},
+		}},
+		{"IsEAN13", validators.IsEAN13, []testCase{
+			{"4006381333931", ""},
+			{"4006381333932", validators.CodeCheckDigit},
+			{"400638133393A", validators.CodeEAN13},
+		}},
+		{"IsUPCA", validators.IsUPCA, []testCase{
+			{"036000291452", ""},
+			{"036000291453", validators.CodeCheckDigit},
+			{"36000291452", validators.CodeUPCA},
+		}},
+	}
+
+	for _, vt := range validatorTests {
+		t.Run(vt.name, func(t *testing.T) {
+			for _, tc := range vt.cases {
+				err := vt.validator("field", tc.value)()
+				if tc.code == "" {
+					assert.Nil(t, err, tc.value)
+					continue
+				}
+				if assert.NotNil(t, err, tc.value) {
+					assert.Equal(t, tc.code, err.Code(), tc.value)
+				}
+			}
+		})
+	}
+
+	t.Run("should name the identifier of wrong check digits", func(t *testing.T) {
+		err := validators.IsISBN("isbn", "978-0-306-40615-8")()
+
+		assert.Equal(t, "has an invalid ISBN-13 check digit", err.Message())
+		assert.Equal(t, map[string]any{"identifier": "ISBN-13"}, err.Params())
+		assert.Equal(t, "978-0-306-40615-8", err.Value())
+	})
+}
+
+func TestIsUUID(t *testing.T) {
+	t.Run("should check the canonical form", func(t *testing.T) {
+		assert.Nil(t, validators.IsUUID("id", "123e4567-e89b-12d3-a456-426614174000", nil)())
+		assert.Nil(t, validators.IsUUID("id", "00000000-0000-0000-0000-000000000000", nil)())
+		assert.Nil(t, validators.IsUUID("id", "123E4567-E89B-12D3-A456-426614174000", nil)())
+
+		for _, value := range []string{"", "123e4567e89b12d3a456426614174000", "{123e4567-e89b-12d3-a456-426614174000}", "123e4567-e89b-12d3-a456-42661417400g"} {
+			err := validators.IsUUID("id", value, nil)()
+			if assert.NotNil(t, err, value) {
+				assert.Equal(t, validators.CodeUUID, err.Code(), value)
+			}
+		}
+	})
+
+	t.Run("should check the version and variant", func(t *testing.T) {
+		versions := []int{4, 7}
+		assert.Nil(t, validators.IsUUID("id", "123e4567-e89b-42d3-a456-426614174000", versions)())
+		assert.Nil(t, validators.IsUUID("id", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", versions)())
+
+		err := validators.IsUUID("id", "123e4567-e89b-12d3-a456-426614174000", versions)()
+		assert.Equal(t, validators.CodeUUIDVersion, err.Code())
+		assert.Equal(t, "must be a UUID of version 4, 7", err.Message())
+
+		// Version 4 with the Microsoft variant
+		err = validators.IsUUID("id", "123e4567-e89b-42d3-c456-426614174000", versions)()
+		assert.Equal(t, validators.CodeUUIDVersion, err.Code())
+	})
+}
+
+func TestIsCreditCard(t *testing.T) {
+	t.Run("should detect the brand", func(t *testing.T) {
+		for number, brand := range map[string]validators.CardBrand{
+			"4111 1111 1111 1111": validators.CardVisa,
+			"5555555555554444":    validators.CardMastercard,
+			"2223003122003222":    validators.CardMastercard,
+			"378282246310005":     validators.CardAmex,
+			"6011111111111117":    validators.CardDiscover,
+			"6221260000000000":    validators.CardDiscover,
+			"30569309025904":      validators.CardDiners,
+			"3530111333300000":    validators.CardJCB,
+			"6200000000000005":    validators.CardUnionPay,
+			"6759649826438453":    validators.CardMaestro,
+			"9111111111111111":    validators.CardUnknown,
+			"411111111111111":     validators.CardUnknown,
+		} {
+			assert.Equal(t, brand, validators.CardBrandOf(number), number)
+		}
+	})
+
+	t.Run("should verify the Luhn check digit", func(t *testing.T) {
+		assert.Nil(t, validators.IsCreditCard("card", "4111-1111-1111-1111", nil)())
+
+		err := validators.IsCreditCard("card", "4111111111111112", nil)()
+		assert.Equal(t, validators.CodeCheckDigit, err.Code())
+		assert.Equal(t, map[string]any{"identifier": "card number"}, err.Params())
+
+		for _, value := range []string{"", "4111", "4111111111111111111111", "4111a11111111111"} {
+			err := validators.IsCreditCard("card", value, nil)()
+			if assert.NotNil(t, err, value) {
+				assert.Equal(t, validators.CodeCreditCard, err.Code(), value)
+			}
+		}
+	})
+
+	t.Run("should restrict the brands", func(t *testing.T) {
+		brands := []validators.CardBrand{validators.CardVisa, validators.CardMastercard}
+		assert.Nil(t, validators.IsCreditCard("card", "5555555555554444", brands)())
+
+		err := validators.IsCreditCard("card", "378282246310005", brands)()
+		assert.Equal(t, validators.CodeCardBrand, err.Code())
+		assert.Equal(t, "must be a card of brand visa, mastercard", err.Message())
+	})
+}
+
+func TestIdentifierRules(t *testing.T) {
+	t.Run("should be usable in groups", func(t *testing.T) {
+		result := govalid.Validate(
+			govalid.Group("id", "not-a-uuid", validators.IsUUIDRule([]int{4})),
+			govalid.Group("card", "378282246310005", validators.IsCreditCardRule([]validators.CardBrand{validators.CardVisa}, "carta non accettata")),
+			govalid.Group("barcode", 4006381333931, validators.IsEAN13Rule()),
+		)
+
+		assert.Equal(t, 3, result.ErrorCount())
+		assert.Equal(t, validators.CodeUUID, result.Errors()[0].Code())
+		assert.Equal(t, "carta non accettata", result.Errors()[1].Message())
+		assert.Equal(t, validators.CodeUnsupportedType, result.Errors()[2].Code())
+	})
+
+	t.Run("should be available as struct tags", func(t *testing.T) {
+		type product struct {
+			ID      string `validate:"uuid=4,7"`
+			Barcode string `validate:"ean13"`
+			ISBN    string `validate:"isbn"`
+			Card    string `validate:"credit_card=visa,amex"`
+		}
+
+		result := govalid.ValidateStruct(product{
+			ID:      "123e4567-e89b-12d3-a456-426614174000",
+			Barcode: "4006381333931",
+			ISBN:    "978-0-306-40615-8",
+			Card:    "378282246310005",
+		})
+
+		assert.Equal(t, 2, result.ErrorCount())
+		assert.Equal(t, validators.CodeUUIDVersion, result.Errors()[0].Code())
+		assert.Equal(t, validators.CodeCheckDigit, result.Errors()[1].Code())
+	})
+
+	t.Run("should be translated", func(t *testing.T) {
+		result := govalid.Validate(validators.IsEAN8("barcode", "96385075")).Localize("it")
+		assert.Equal(t, "ha una cifra di controllo EAN-8 non valida", result.Errors()[0].Message())
+	})
+}
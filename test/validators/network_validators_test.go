@@ -0,0 +1,181 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkValidators(t *testing.T) {
+	type testCase struct {
+		value string
+		valid bool
+	}
+
+	validatorTests := []struct {
+		name      string
+		validator func(field, value string, args ...string) govalid.ValidationFunc
+		code      string
+		cases     []testCase
+	}{
+		{"IsHostname", validators.IsHostname, validators.CodeHostname, []testCase{
+			{"example.com", true},
+			{"example.com.", true},
+			{"localhost", true},
+			{"3com.net", true},
+			{"my-host.internal", true},
+			{"", false},
+			{"-example.com", false},
+			{"example-.com", false},
+			{"exa_mple.com", false},
+			{"example..com", false},
+			{"192.168.0.1", false},
+			{string(make([]byte, 64)) + ".com", false},
+		}},
+		{"IsIP", validators.IsIP, validators.CodeIP, []testCase{
+			{"192.168.0.1", true},
+			{"2001:db8::1", true},
+			{"256.0.0.1", false},
+			{"example.com", false},
+		}},
+		{"IsIPv4", validators.IsIPv4, validators.CodeIPv4, []testCase{
+			{"10.0.0.1", true},
+			{"2001:db8::1", false},
+			{"::ffff:10.0.0.1", false},
+			{"10.0.0", false},
+		}},
+		{"IsIPv6", validators.IsIPv6, validators.CodeIPv6, []testCase{
+			{"2001:db8::1", true},
+			{"::1", true},
+			{"10.0.0.1", false},
+			{"2001:db8::g", false},
+		}},
+		{"IsCIDR", validators.IsCIDR, validators.CodeCIDR, []testCase{
+			{"10.0.0.0/8", true},
+			{"2001:db8::/32", true},
+			{"10.0.0.0/33", false},
+			{"10.0.0.0", false},
+		}},
+		{"IsMAC", validators.IsMAC, validators.CodeMAC, []testCase{
+			{"00:1a:2b:3c:4d:5e", true},
+			{"00-1A-2B-3C-4D-5E", true},
+			{"001a.2b3c.4d5e", true},
+			{"00:1a:2b:3c:4d", false},
+			{"00:1a:2b:3c:4d:zz", false},
+		}},
+		{"IsHostPort", validators.IsHostPort, validators.CodeHostPort, []testCase{
+			{"example.com:443", true},
+			{"10.0.0.1:8080", true},
+			{"[2001:db8::1]:443", true},
+			{"2001:db8::1:443", false},
+			{"example.com", false},
+			{":8080", false},
+			{"example.com:0", false},
+			{"example.com:65536", false},
+			{"exa_mple.com:80", false},
+		}},
+	}
+
+	for _, vt := range validatorTests {
+		t.Run(vt.name, func(t *testing.T) {
+			for _, tc := range vt.cases {
+				err := vt.validator("field", tc.value)()
+				if tc.valid {
+					assert.Nil(t, err, tc.value)
+					continue
+				}
+				if assert.NotNil(t, err, tc.value) {
+					assert.Equal(t, vt.code, err.Code(), tc.value)
+					assert.Equal(t, tc.value, err.Value(), tc.value)
+				}
+			}
+		})
+	}
+}
+
+func TestIsURL(t *testing.T) {
+	t.Run("should accept absolute URLs", func(t *testing.T) {
+		assert.Nil(t, validators.IsURL("url", "https://example.com/path?q=1", nil)())
+		assert.Nil(t, validators.IsURL("url", "ftp://files.example.com", nil)())
+	})
+
+	t.Run("should reject relative or malformed URLs", func(t *testing.T) {
+		for _, value := range []string{"", "example.com", "/path", "https://", "http://exa mple.com", "mailto:mario@example.com"} {
+			err := validators.IsURL("url", value, nil)()
+			if assert.NotNil(t, err, value) {
+				assert.Equal(t, validators.CodeURL, err.Code(), value)
+			}
+		}
+	})
+
+	t.Run("should restrict the scheme", func(t *testing.T) {
+		schemes := []string{"http", "https"}
+		assert.Nil(t, validators.IsURL("url", "HTTPS://example.com", schemes)())
+
+		err := validators.IsURL("url", "ftp://example.com", schemes)()
+		assert.Equal(t, validators.CodeURLScheme, err.Code())
+		assert.Equal(t, "must use one of the schemes http, https", err.Message())
+		assert.Equal(t, map[string]any{"schemes": "http, https"}, err.Params())
+	})
+
+	t.Run("should support custom messages", func(t *testing.T) {
+		err := validators.IsURL("url", "nope", nil, "indirizzo non valido")()
+		assert.Equal(t, "indirizzo non valido", err.Message())
+	})
+}
+
+func TestIsPort(t *testing.T) {
+	type port uint16
+
+	for _, value := range []any{80, "443", int64(65535), port(8080), uint8(1)} {
+		assert.Nil(t, validators.IsPort("port", value)(), value)
+	}
+
+	for _, value := range []any{0, -1, 65536, "0", "http", "80.5", 80.5, ""} {
+		err := validators.IsPort("port", value)()
+		if assert.NotNil(t, err, value) {
+			assert.Equal(t, validators.CodePort, err.Code(), value)
+		}
+	}
+
+	err := validators.IsPort("port", true)()
+	assert.Equal(t, validators.CodeUnsupportedType, err.Code())
+}
+
+func TestNetworkRules(t *testing.T) {
+	t.Run("should be usable in groups", func(t *testing.T) {
+		result := govalid.Validate(
+			govalid.Group("callback", "ftp://example.com", validators.IsURLRule([]string{"https"})),
+			govalid.Group("listen", "0.0.0.0:8080", validators.IsHostPortRule()),
+			govalid.Group("port", 0, validators.IsPortRule("porta non valida")),
+			govalid.Group("gateway", 42, validators.IsIPRule()),
+		)
+
+		assert.Equal(t, 3, result.ErrorCount())
+		assert.Equal(t, validators.CodeURLScheme, result.Errors()[0].Code())
+		assert.Equal(t, "porta non valida", result.Errors()[1].Message())
+		assert.Equal(t, validators.CodeUnsupportedType, result.Errors()[2].Code())
+	})
+
+	t.Run("should be available as struct tags", func(t *testing.T) {
+		type server struct {
+			Host     string `validate:"hostname"`
+			Callback string `validate:"url=http,https"`
+			Port     int    `validate:"port"`
+			Network  string `validate:"cidr"`
+		}
+
+		result := govalid.ValidateStruct(server{Host: "example.com", Callback: "ws://example.com", Port: 70000, Network: "10.0.0.0/8"})
+
+		assert.Equal(t, 2, result.ErrorCount())
+		assert.Equal(t, validators.CodeURLScheme, result.Errors()[0].Code())
+		assert.Equal(t, validators.CodePort, result.Errors()[1].Code())
+	})
+
+	t.Run("should be translated", func(t *testing.T) {
+		result := govalid.Validate(validators.IsIPv4("ip", "::1")).Localize("it")
+		assert.Equal(t, "deve essere un indirizzo IPv4 valido", result.Errors()[0].Message())
+	})
+}
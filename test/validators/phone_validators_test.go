@@ -0,0 +1,159 @@
+package validators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePhone(t *testing.T) {
+	t.Run("should normalize numbers to E.164", func(t *testing.T) {
+		tests := []struct {
+			value          string
+			defaultCountry string
+			e164           string
+			country        string
+			phoneType      validators.PhoneType
+		}{
+			{"+39 06 1234 5678", "", "+390612345678", "IT", validators.PhoneFixed},
+			{"0039 312 345 6789", "", "+393123456789", "IT", validators.PhoneMobile},
+			{"312-345-6789", "IT", "+393123456789", "IT", validators.PhoneMobile},
+			{"800 123 456", "IT", "+39800123456", "IT", validators.PhoneTollFree},
+			{"+44 (0)20 7946 0000", "", "+442079460000", "GB", validators.PhoneFixed},
+			{"07700 900123", "GB", "+447700900123", "GB", validators.PhoneMobile},
+			{"030 123456", "de", "+4930123456", "DE", validators.PhoneFixed},
+			{"+49 151 23456789", "", "+4915123456789", "DE", validators.PhoneMobile},
+			{"06 12 34 56 78", "FR", "+33612345678", "FR", validators.PhoneMobile},
+			{"+1 (415) 555-2671", "", "+14155552671", "US", validators.PhoneFixedOrMobile},
+			{"1 416 555 0100", "CA", "+14165550100", "CA", validators.PhoneFixedOrMobile},
+			{"+1 416 555 0100", "", "+14165550100", "CA", validators.PhoneFixedOrMobile},
+			{"+1 800 555 0100", "", "+18005550100", "CA", validators.PhoneTollFree},
+			{"+1 800 555 0100", "US", "+18005550100", "US", validators.PhoneTollFree},
+		}
+
+		for _, tc := range tests {
+			phone, err := validators.ParsePhone(tc.value, tc.defaultCountry)
+			require.NoError(t, err, tc.value)
+			assert.Equal(t, tc.e164, phone.E164(), tc.value)
+			assert.Equal(t, tc.country, phone.Country, tc.value)
+			assert.Equal(t, tc.phoneType, phone.Type, tc.value)
+		}
+	})
+
+	t.Run("should return which part failed", func(t *testing.T) {
+		tests := []struct {
+			value          string
+			defaultCountry string
+			err            error
+		}{
+			{"", "IT", validators.ErrPhoneFormat},
+			{"+39 06 CALL ME", "", validators.ErrPhoneFormat},
+			{"+39 0612345678901234", "", validators.ErrPhoneFormat},
+			{"06 1234 5678", "", validators.ErrPhoneCountry},
+			{"06 1234 5678", "XX", validators.ErrPhoneCountry},
+			{"+999 1234 5678", "", validators.ErrPhoneCountry},
+			{"+39 06 12", "", validators.ErrPhoneLength},
+			{"+33 6 12 34 56 7", "", validators.ErrPhoneLength},
+			{"+39 512 345 6789", "", validators.ErrPhoneNumber},
+			{"+1 415 155 2671", "", validators.ErrPhoneNumber},
+		}
+
+		for _, tc := range tests {
+			_, err := validators.ParsePhone(tc.value, tc.defaultCountry)
+			assert.True(t, errors.Is(err, tc.err), "%s: %v", tc.value, err)
+		}
+	})
+}
+
+func TestNormalizePhone(t *testing.T) {
+	t.Run("should return the E.164 form", func(t *testing.T) {
+		phone, err := validators.NormalizePhone("06 1234 5678", "IT")
+		require.NoError(t, err)
+		assert.Equal(t, "+390612345678", phone)
+	})
+
+	t.Run("should return the parsing errors", func(t *testing.T) {
+		phone, err := validators.NormalizePhone("06 12", "IT")
+		assert.True(t, errors.Is(err, validators.ErrPhoneLength))
+		assert.Empty(t, phone)
+	})
+}
+
+func TestIsPhone(t *testing.T) {
+	t.Run("should restrict the countries", func(t *testing.T) {
+		opts := validators.PhoneOptions{Countries: []string{"IT", "SM"}}
+		assert.Nil(t, validators.IsPhone("phone", "+39 06 1234 5678", opts)())
+
+		err := validators.IsPhone("phone", "+49 30 123456", opts)()
+		assert.Equal(t, validators.CodePhoneCountry, err.Code())
+		assert.Equal(t, "must be a phone number of one of the countries IT, SM", err.Message())
+
+		err = validators.IsPhone("phone", "06 1234 5678", validators.PhoneOptions{})()
+		assert.Equal(t, validators.CodePhoneCountry, err.Code())
+	})
+
+	t.Run("should accept numbers shared by the allowed countries", func(t *testing.T) {
+		assert.Nil(t, validators.IsPhone("phone", "+1 800 555 0100", validators.PhoneOptions{Countries: []string{"US"}})())
+		assert.Nil(t, validators.IsPhone("phone", "+1 800 555 0100", validators.PhoneOptions{Countries: []string{"CA"}})())
+
+		err := validators.IsPhone("phone", "+1 416 555 0100", validators.PhoneOptions{Countries: []string{"US"}})()
+		assert.Equal(t, validators.CodePhoneCountry, err.Code())
+	})
+
+	t.Run("should restrict the types", func(t *testing.T) {
+		mobile := validators.PhoneOptions{Types: []validators.PhoneType{validators.PhoneMobile}}
+		assert.Nil(t, validators.IsPhone("phone", "+39 312 345 6789", mobile)())
+		assert.Nil(t, validators.IsPhone("phone", "+1 415 555 2671", mobile)())
+
+		err := validators.IsPhone("phone", "+39 06 1234 5678", mobile)()
+		assert.Equal(t, validators.CodePhoneType, err.Code())
+		assert.Equal(t, map[string]any{"types": "mobile"}, err.Params())
+
+		err = validators.IsPhone("phone", "+1 800 555 0100", mobile)()
+		assert.Equal(t, validators.CodePhoneType, err.Code())
+	})
+
+	t.Run("should report invalid prefixes", func(t *testing.T) {
+		err := validators.IsPhone("phone", "+39 512 345 6789", validators.PhoneOptions{})()
+		assert.Equal(t, validators.CodePhone, err.Code())
+		assert.Equal(t, "+39 512 345 6789", err.Value())
+	})
+}
+
+func TestPhoneRules(t *testing.T) {
+	t.Run("should be usable in groups", func(t *testing.T) {
+		result := govalid.Validate(
+			govalid.Group("phone", "0612345678", validators.IsPhoneRule(validators.PhoneOptions{DefaultCountry: "IT"})),
+			govalid.Group("mobile", "0612345678", validators.IsPhoneRule(validators.PhoneOptions{
+				DefaultCountry: "IT",
+				Types:          []validators.PhoneType{validators.PhoneMobile},
+			}, "serve un cellulare")),
+		)
+
+		assert.Equal(t, 1, result.ErrorCount())
+		assert.Equal(t, "serve un cellulare", result.Errors()[0].Message())
+	})
+
+	t.Run("should be available as struct tags", func(t *testing.T) {
+		type contact struct {
+			Phone  string `validate:"phone=IT"`
+			Mobile string `validate:"mobile_phone=IT"`
+			Other  string `validate:"phone"`
+		}
+
+		result := govalid.ValidateStruct(contact{Phone: "06 1234 5678", Mobile: "06 1234 5678", Other: "06 1234 5678"})
+
+		assert.Equal(t, 2, result.ErrorCount())
+		assert.Equal(t, validators.CodePhoneType, result.Errors()[0].Code())
+		assert.Equal(t, validators.CodePhoneCountry, result.Errors()[1].Code())
+	})
+
+	t.Run("should be translated", func(t *testing.T) {
+		result := govalid.Validate(validators.IsPhone("phone", "+39 06 12", validators.PhoneOptions{})).Localize("de")
+		assert.Equal(t, "hat eine ungültige Länge für eine Telefonnummer", result.Errors()[0].Message())
+	})
+}
@@ -0,0 +1,130 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/schema"
+	"github.com/Palma99/govalid/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostalCode(t *testing.T) {
+	t.Run("should check the format of the country", func(t *testing.T) {
+		tests := []struct {
+			country string
+			valid   []string
+			invalid []string
+		}{
+			{"IT", []string{"00184", " 20121 "}, []string{"0018", "001844", "OO184"}},
+			{"GB", []string{"SW1A 1AA", "sw1a1aa", "M1 1AE", "EC1A 1BB", "GIR 0AA"}, []string{"SW1A 1A", "QA1 1AA", "12345"}},
+			{"NL", []string{"1012 AB", "1012AB"}, []string{"0123 AB", "1012 A"}},
+			{"CA", []string{"K1A 0B1", "k1a0b1"}, []string{"D1A 0B1", "K1A 0B"}},
+			{"US", []string{"20500", "20500-0003"}, []string{"2050", "20500-03"}},
+			{"PL", []string{"00-950"}, []string{"00950"}},
+			{"PT", []string{"1000-001"}, []string{"1000"}},
+			{"ES", []string{"28001", "52001"}, []string{"53001", "00001"}},
+			{"IE", []string{"D02 X285", "D6W1234"}, []string{"D02 X28", "B02 X285"}},
+			{"JP", []string{"100-0001", "1000001"}, []string{"100-001"}},
+		}
+
+		for _, tc := range tests {
+			for _, value := range tc.valid {
+				assert.Nil(t, validators.PostalCode("zip", value, tc.country)(), "%s %s", tc.country, value)
+			}
+			for _, value := range tc.invalid {
+				err := validators.PostalCode("zip", value, tc.country)()
+				if assert.NotNil(t, err, "%s %s", tc.country, value) {
+					assert.Equal(t, validators.CodePostalCode, err.Code())
+				}
+			}
+		}
+	})
+
+	t.Run("should include an example in errors", func(t *testing.T) {
+		err := validators.PostalCode("zip", "1234", "it")()
+
+		assert.Equal(t, "must be a valid IT postal code, i.e. 00184", err.Message())
+		assert.Equal(t, map[string]any{"country": "IT", "example": "00184"}, err.Params())
+		assert.Equal(t, "1234", err.Value())
+	})
+
+	t.Run("should not check unknown or missing countries", func(t *testing.T) {
+		assert.Nil(t, validators.PostalCode("zip", "anything", "ZZ")())
+		assert.Nil(t, validators.PostalCode("zip", "anything", "")())
+
+		err := validators.PostalCode("zip", "00184", "ITA")()
+		assert.Equal(t, validators.CodePostalCodeCountry, err.Code())
+	})
+}
+
+func TestPostalCodeRule(t *testing.T) {
+	type address struct {
+		Country        string  `json:"country"`
+		Zip            string  `json:"zip" validate:"postal_code=country"`
+		BillingCountry *string `json:"billing_country"`
+		Billing        string  `json:"billing" validate:"postal_code=billing_country"`
+	}
+
+	t.Run("should be usable in groups", func(t *testing.T) {
+		a := address{Country: "FR", Zip: "7500"}
+		result := govalid.Validate(govalid.Group("zip", a.Zip, validators.PostalCodeRule(a.Country, "code postal invalide")))
+
+		assert.Equal(t, 1, result.ErrorCount())
+		assert.Equal(t, "code postal invalide", result.Errors()[0].Message())
+	})
+
+	t.Run("should read the country from another field", func(t *testing.T) {
+		country := "FR"
+		result := govalid.Validate(govalid.Group("zip", "7500", validators.PostalCodeFieldRule("country", &country)))
+
+		assert.Equal(t, 1, result.ErrorCount())
+		assert.Equal(t, map[string]any{"country": "FR", "example": "75001", "country_field": "country"}, result.Errors()[0].Params())
+
+		var missing *string
+		assert.True(t, govalid.Validate(govalid.Group("zip", "7500", validators.PostalCodeFieldRule("country", missing))).IsValid())
+	})
+
+	t.Run("should read the country from a sibling field", func(t *testing.T) {
+		de := "DE"
+		assert.True(t, govalid.ValidateStruct(address{Country: "IT", Zip: "00184", BillingCountry: &de, Billing: "10115"}).IsValid())
+		assert.True(t, govalid.ValidateStruct(address{Country: "IT", Zip: "00184", Billing: "1011"}).IsValid())
+
+		result := govalid.ValidateStruct(address{Country: "NL", Zip: "00184", BillingCountry: &de, Billing: "1011"})
+		assert.Equal(t, 2, result.ErrorCount())
+		assert.Equal(t, map[string]any{"country": "NL", "example": "1012 AB", "country_field": "country"}, result.Errors()[0].Params())
+		assert.Equal(t, "DE", result.Errors()[1].Params()["country"])
+	})
+
+	t.Run("should panic on unknown fields", func(t *testing.T) {
+		type invalid struct {
+			Zip string `validate:"postal_code=Nation"`
+		}
+		assert.Panics(t, func() { govalid.ValidateStruct(invalid{}) })
+
+		// Country codes are not accepted, so a misspelled field is not taken for one
+		type literal struct {
+			Zip string `validate:"postal_code=DE"`
+		}
+		assert.Panics(t, func() { govalid.ValidateStruct(literal{}) })
+	})
+
+	t.Run("should work in schema files", func(t *testing.T) {
+		s, err := schema.Parse([]byte(`
+fields:
+  country: []
+  zip:
+    - postal_code: country
+`))
+		require.NoError(t, err)
+
+		result := s.Validate(map[string]any{"country": "DE", "zip": "1011"})
+		assert.Equal(t, validators.CodePostalCode, result.Errors()[0].Code())
+	})
+
+	t.Run("should be translated", func(t *testing.T) {
+		result := govalid.Validate(validators.PostalCode("cap", "1234", "IT")).Localize("it")
+		assert.Equal(t, "deve essere un codice postale IT valido, ad esempio 00184", result.Errors()[0].Message())
+	})
+}
@@ -0,0 +1,79 @@
+package typed_test
+
+import (
+	"testing"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/validators"
+	"github.com/Palma99/govalid/validators/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringRules(t *testing.T) {
+	type testCase struct {
+		name  string
+		rule  govalid.TypedRule[string]
+		valid string
+		wrong string
+		code  string
+	}
+
+	testCases := []testCase{
+		{"NonEmpty", typed.NonEmpty(), "Mario", " ", validators.CodeNonEmpty},
+		{"MinLength", typed.MinLength(3), "Mario", "Al", validators.CodeMinLength},
+		{"MaxLength", typed.MaxLength(3), "Al", "Mario", validators.CodeMaxLength},
+		{"MatchesRegex", typed.MatchesRegex(`^[a-z]+$`), "abc", "abc1", validators.CodeMatchesRegex},
+		{"IsEmail", typed.IsEmail(), "mario@example.com", "mario", validators.CodeEmail},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Nil(t, tc.rule("field", tc.valid)())
+
+			err := tc.rule("field", tc.wrong)()
+			assert.NotNil(t, err)
+			assert.Equal(t, "field", err.Field())
+			assert.Equal(t, tc.code, err.Code())
+		})
+	}
+}
+
+func TestNumberRules(t *testing.T) {
+	type age int64
+
+	t.Run("Min", func(t *testing.T) {
+		assert.Nil(t, typed.Min(18)("age", 18)())
+		assert.NotNil(t, typed.Min(18)("age", 17)())
+		assert.NotNil(t, typed.Min(age(18))("age", age(3))())
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		assert.Nil(t, typed.Max(1.5)("score", 1.5)())
+		assert.NotNil(t, typed.Max(1.5)("score", 1.6)())
+	})
+}
+
+func TestSliceRules(t *testing.T) {
+	assert.NotNil(t, typed.NonEmptySlice[string]()("tags", nil)())
+	assert.Nil(t, typed.NonEmptySlice[string]()("tags", []string{"a"})())
+	assert.NotNil(t, typed.MinItems[int](2)("ids", []int{1})())
+	assert.NotNil(t, typed.MaxItems[int](1)("ids", []int{1, 2})())
+}
+
+func TestCustom(t *testing.T) {
+	isEven := typed.Custom(func(value int) *string {
+		if value%2 == 0 {
+			return nil
+		}
+		msg := "must be even"
+		return &msg
+	})
+
+	assert.Nil(t, isEven("n", 2)())
+	assert.Equal(t, "must be even", isEven("n", 3)().Message())
+}
+
+func TestCustomMessage(t *testing.T) {
+	err := typed.MinLength(3, "troppo corto")("name", "Al")()
+	assert.Equal(t, "troppo corto", err.Message())
+}
@@ -0,0 +1,188 @@
+package govalid
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Validatable is implemented by types that validate themselves, so they can be passed to
+// Validate and ValidateShortCircuit like ValidationFunc
+//
+//	func (a Address) Validate() govalid.ValidationResult {
+//		return govalid.Validate(validators.NonEmpty("city", a.City))
+//	}
+//
+// Validatable values nested in struct fields, slices, arrays and maps are discovered,
+// their errors are prefixed with their path, i.e. "addresses[1].city", so a Validate
+// method should not validate its Validatable fields itself
+// Embedded Validatable values are not validated again when the struct is Validatable, as
+// their Validate method is promoted to the struct or replaced by the struct one
+// Fields tagged `validate:"-"` are skipped, map values are not addressable so only
+// their value receiver Validate methods are found
+// A Validate method validating its nested values itself opts out with NestingValidatable
+type Validatable interface {
+	Validate() ValidationResult
+}
+
+// NestingValidatable is a Validatable whose Validate method already validates the values
+// nested in it, like the methods generated by govalid-gen, so they are not discovered again
+type NestingValidatable interface {
+	Validatable
+	// Only marks the type, it is never called
+	ValidatesNested()
+}
+
+var validatableType = reflect.TypeFor[Validatable]()
+
+type visitKey struct {
+	pointer uintptr
+	t       reflect.Type
+}
+
+type discovery struct {
+	failFastMode bool
+	visited      map[visitKey]bool
+	errors       []ValidationError
+}
+
+// Returns the errors of v and of the Validatable values it contains
+func validateValidatable(failFastMode bool, v Validatable) ValidationResult {
+	d := &discovery{failFastMode: failFastMode, visited: map[visitKey]bool{}}
+	d.collect(nil, v)
+	if _, ok := v.(NestingValidatable); ok {
+		return NewValidationResult(d.errors...)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer {
+		// A copy is addressable, so pointer receiver Validate methods of fields are found
+		addressable := reflect.New(rv.Type()).Elem()
+		addressable.Set(rv)
+		rv = addressable
+	}
+	d.walk(nil, rv)
+
+	return NewValidationResult(d.errors...)
+}
+
+func (d *discovery) done() bool {
+	return d.failFastMode && len(d.errors) > 0
+}
+
+func (d *discovery) collect(path Path, v Validatable) {
+	for _, err := range v.Validate().Errors() {
+		d.errors = append(d.errors, *err.WithPrefix(path))
+		if d.done() {
+			return
+		}
+	}
+}
+
+// Checks if the value is Validatable, then looks for Validatable values inside it
+func (d *discovery) visit(path Path, rv reflect.Value) {
+	if d.done() {
+		return
+	}
+
+	if v, ok := asValidatable(rv); ok {
+		d.collect(path, v)
+		if _, ok := v.(NestingValidatable); ok {
+			return
+		}
+	}
+	d.walk(path, rv)
+}
+
+func (d *discovery) walk(path Path, rv reflect.Value) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return
+		}
+		if rv.Kind() == reflect.Pointer {
+			key := visitKey{rv.Pointer(), rv.Type()}
+			if d.visited[key] {
+				return
+			}
+			d.visited[key] = true
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		rt := rv.Type()
+		for i := 0; i < rt.NumField(); i++ {
+			field := rt.Field(i)
+			if !field.IsExported() || field.Tag.Get(structTagName) == "-" {
+				continue
+			}
+
+			fieldPath := path.Append(FieldSegment(structFieldName(field)))
+			// The Validate method of an embedded value is promoted to the struct, or replaced by
+			// its own one, so it already ran with the struct. Its fields are still walked
+			if field.Anonymous && isValidatable(rt) && isValidatable(field.Type) {
+				d.walk(fieldPath, rv.Field(i))
+				continue
+			}
+			d.visit(fieldPath, rv.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if !mayContainValidatable(rv.Type().Elem()) {
+			return
+		}
+		for i := 0; i < rv.Len(); i++ {
+			d.visit(path.Append(IndexSegment(i)), rv.Index(i))
+		}
+	case reflect.Map:
+		if !mayContainValidatable(rv.Type().Elem()) {
+			return
+		}
+		values := make(map[string]reflect.Value, rv.Len())
+		for _, key := range rv.MapKeys() {
+			values[fmt.Sprint(key.Interface())] = rv.MapIndex(key)
+		}
+		for _, name := range sortedKeys(values) {
+			d.visit(path.Append(KeySegment(name)), values[name])
+		}
+	}
+}
+
+func asValidatable(rv reflect.Value) (Validatable, bool) {
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil, false
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || !rv.CanInterface() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return nil, false
+	}
+
+	if rv.Type().Implements(validatableType) {
+		return rv.Interface().(Validatable), true
+	}
+	if rv.CanAddr() && rv.Addr().Type().Implements(validatableType) {
+		return rv.Addr().Interface().(Validatable), true
+	}
+	return nil, false
+}
+
+func isValidatable(t reflect.Type) bool {
+	return t.Implements(validatableType) || reflect.PointerTo(t).Implements(validatableType)
+}
+
+// Elements of basic types can not be Validatable, so they are not visited one by one
+func mayContainValidatable(t reflect.Type) bool {
+	if isValidatable(t) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return false
+	}
+	return true
+}
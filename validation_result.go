@@ -1,6 +1,10 @@
 package govalid
 
-import "github.com/Palma99/govalid/internal"
+import (
+	"encoding/json"
+
+	"github.com/Palma99/govalid/internal"
+)
 
 type ValidationResult struct {
 	errors []internal.ValidationError
@@ -74,3 +78,48 @@ func (r *ValidationResult) FirstError() *internal.ValidationError {
 func (r *ValidationResult) addError(err internal.ValidationError) {
 	r.errors = append(r.errors, err)
 }
+
+type validationResultJSON struct {
+	Errors []internal.ValidationError `json:"errors"`
+}
+
+func (r ValidationResult) MarshalJSON() ([]byte, error) {
+	errors := r.errors
+	if errors == nil {
+		errors = []internal.ValidationError{}
+	}
+	return json.Marshal(validationResultJSON{Errors: errors})
+}
+
+func (r *ValidationResult) UnmarshalJSON(data []byte) error {
+	var decoded validationResultJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	r.errors = decoded.Errors
+	return nil
+}
+
+// Returns a copy of the result with prefix prepended to the path of every error,
+// i.e. to nest the result of a child value
+func (r ValidationResult) WithPrefix(prefix string) ValidationResult {
+	prefixPath := internal.ParsePath(prefix)
+
+	result := NewValidationResult()
+	for _, err := range r.errors {
+		result.addError(*err.WithPrefix(prefixPath))
+	}
+
+	return result
+}
+
+// Returns a new result containing the errors of r followed by the errors of others
+func (r ValidationResult) Merge(others ...ValidationResult) ValidationResult {
+	result := NewValidationResult(r.errors...)
+	for _, other := range others {
+		result.errors = append(result.errors, other.errors...)
+	}
+
+	return result
+}
@@ -0,0 +1,17 @@
+package validators
+
+import (
+	"github.com/Palma99/govalid"
+)
+
+func IsIBANRule(countries []string, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsIBAN(field, value, countries, customMessage...)
+	})
+}
+
+func IsBICRule(countries []string, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsBIC(field, value, countries, customMessage...)
+	})
+}
@@ -0,0 +1,102 @@
+package validators
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+)
+
+// Checks that the value is an IBAN, i.e. IT60 X054 2811 1010 0000 0123 456
+// Spaces are ignored, lowercase letters are accepted
+// The length and structure are checked against the IBAN registry, then the mod-97 check digits
+// If countries is not empty the country code must be one of them, i.e. SEPACountries
+//
+// Errors have the code CodeIBANCountry for an unknown or not allowed country, CodeIBANLength
+// for a wrong length, CodeIBAN for a malformed account number and CodeCheckDigit
+func IsIBAN(fieldName, value string, countries []string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		iban := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+
+		country := ""
+		if len(iban) >= 2 {
+			country = iban[:2]
+		}
+		format, ok := ibanFormats[country]
+
+		if !ok || !countryAllowed(countries, country) {
+			allowed := strings.Join(countries, ", ")
+			if len(countries) == 0 {
+				allowed = strings.Join(sortedIBANCountries(), ", ")
+			}
+			return internal.NewValidationErrorf(fieldName, "must be an IBAN of one of the countries %s", allowed).
+				WithCode(CodeIBANCountry).WithParam("countries", allowed).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if len(iban) != format.length {
+			return internal.NewValidationErrorf(fieldName, "must be %d characters long for %s", format.length, country).
+				WithCode(CodeIBANLength).WithParam("length", format.length).WithParam("country", country).
+				WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !isDigits(iban[2:4]) || !format.pattern.MatchString(iban[4:]) {
+			return internal.NewValidationErrorf(fieldName, "must be a valid %s IBAN", country).
+				WithCode(CodeIBAN).WithParam("country", country).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if ibanMod97(iban) != 1 {
+			return checkDigitError(fieldName, value, "IBAN", args...)
+		}
+		return nil
+	}
+}
+
+// ISO 7064 MOD 97-10: the first 4 characters are moved to the end, letters are
+// replaced by 10 to 35 and the number must have a remainder of 1
+func ibanMod97(iban string) int {
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	return remainder
+}
+
+// Checks that the value is a BIC (SWIFT code) of 8 or 11 characters, i.e. UNCRITMM or UNCRITMMXXX
+// The value is the bank code, the country code, the location and the optional branch
+// If countries is not empty the country code must be one of them
+//
+// Errors have the code CodeBIC for a malformed code and CodeBICCountry for a not allowed country
+func IsBIC(fieldName, value string, countries []string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		bic := strings.ToUpper(value)
+		if (len(bic) != 8 && len(bic) != 11) || !isLetters(bic[:6]) || !isAlphanumeric(bic[6:]) {
+			return internal.NewValidationError(fieldName, "must be a valid BIC").
+				WithCode(CodeBIC).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !countryAllowed(countries, bic[4:6]) {
+			allowed := strings.Join(countries, ", ")
+			return internal.NewValidationErrorf(fieldName, "must be a BIC of one of the countries %s", allowed).
+				WithCode(CodeBICCountry).WithParam("countries", allowed).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+func countryAllowed(countries []string, country string) bool {
+	return len(countries) == 0 || slices.ContainsFunc(countries, func(c string) bool { return strings.EqualFold(c, country) })
+}
+
+func isAlphanumeric(value string) bool {
+	for _, c := range value {
+		if !(c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return value != ""
+}
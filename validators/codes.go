@@ -0,0 +1,66 @@
+package validators
+
+import "github.com/Palma99/govalid"
+
+// Error codes set by the built-in validators, see ValidationError.Code()
+const (
+	CodeCustom          = "custom"
+	CodeNonEmpty        = "non_empty"
+	CodeMin             = "min"
+	CodeMax             = "max"
+	CodeMinLength       = "min_length"
+	CodeMaxLength       = "max_length"
+	CodeMatchesRegex    = "matches_regex"
+	CodeEmail           = "email"
+	CodeUnsupportedType = govalid.CodeUnsupportedType
+
+	CodeEqualToField            = "eq_field"
+	CodeNotEqualToField         = "ne_field"
+	CodeGreaterThanField        = "gt_field"
+	CodeGreaterThanOrEqualField = "gte_field"
+	CodeLessThanField           = "lt_field"
+	CodeLessThanOrEqualField    = "lte_field"
+
+	CodeURL       = "url"
+	CodeURLScheme = "url_scheme"
+	CodeHostname  = "hostname"
+	CodeIP        = "ip"
+	CodeIPv4      = "ipv4"
+	CodeIPv6      = "ipv6"
+	CodeCIDR      = "cidr"
+	CodePort      = "port"
+	CodeMAC       = "mac"
+	CodeHostPort  = "host_port"
+
+	CodeCheckDigit  = "check_digit"
+	CodeUUID        = "uuid"
+	CodeUUIDVersion = "uuid_version"
+	CodeULID        = "ulid"
+	CodeISBN        = "isbn"
+	CodeEAN8        = "ean8"
+	CodeEAN13       = "ean13"
+	CodeUPCA        = "upc_a"
+	CodeCreditCard  = "credit_card"
+	CodeCardBrand   = "card_brand"
+
+	CodeCodiceFiscale          = "codice_fiscale"
+	CodeCodiceFiscaleBirthDate = "codice_fiscale_birth_date"
+	CodePartitaIVA             = "partita_iva"
+	CodePartitaIVAOffice       = "partita_iva_office"
+	CodeVAT                    = "vat"
+	CodeVATCountry             = "vat_country"
+
+	CodeIBAN        = "iban"
+	CodeIBANCountry = "iban_country"
+	CodeIBANLength  = "iban_length"
+	CodeBIC         = "bic"
+	CodeBICCountry  = "bic_country"
+
+	CodePhone        = "phone"
+	CodePhoneCountry = "phone_country"
+	CodePhoneLength  = "phone_length"
+	CodePhoneType    = "phone_type"
+
+	CodePostalCode        = "postal_code"
+	CodePostalCodeCountry = "postal_code_country"
+)
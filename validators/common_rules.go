@@ -24,13 +24,13 @@ func NonEmptyRule(customMessage ...string) govalid.ValidationRule {
 
 func MaxLengthRule(max int, customMessage ...string) govalid.ValidationRule {
 	return func(field string, value any) govalid.ValidationFunc {
-		return MaxLength(field, value.(string), max, customMessage...)
+		return MaxLength(field, value, max, customMessage...)
 	}
 }
 
 func MinLengthRule(min int, customMessage ...string) govalid.ValidationRule {
 	return func(field string, value any) govalid.ValidationFunc {
-		return MinLength(field, value.(string), min, customMessage...)
+		return MinLength(field, value, min, customMessage...)
 	}
 }
 
@@ -47,13 +47,23 @@ func MinRule(min int, customMessage ...string) govalid.ValidationRule {
 }
 
 func MatchesRegexRule(pattern string, customMessage ...string) govalid.ValidationRule {
-	return func(field string, value any) govalid.ValidationFunc {
-		return MatchesRegex(field, value.(string), pattern, customMessage...)
-	}
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return MatchesRegex(field, value, pattern, customMessage...)
+	})
 }
 
 func IsEmailRule(customMessage ...string) govalid.ValidationRule {
-	return func(field string, value any) govalid.ValidationFunc {
-		return IsEmail(field, value.(string), customMessage...)
-	}
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsEmail(field, value, customMessage...)
+	})
+}
+
+// Checks that the value is not empty only if predicate returns true
+func RequiredIf(predicate func() bool, customMessage ...string) govalid.ValidationRule {
+	return govalid.WhenRule(predicate, NonEmptyRule(customMessage...))
+}
+
+// Checks that the value is not empty only if predicate returns false
+func RequiredUnless(predicate func() bool, customMessage ...string) govalid.ValidationRule {
+	return govalid.UnlessRule(predicate, NonEmptyRule(customMessage...))
 }
@@ -1,7 +1,12 @@
+// Package validators provides the built-in validations, as functions checking a value and as
+// rules to use with govalid.Group, and registers them in govalid.DefaultRegistry for struct tags
+//
+// Rules receive the value as any: values of a type a rule does not support, i.e. a number
+// passed to IsEmailRule, fail with a govalid.CodeUnsupportedType error instead of panicking
 package validators
 
 import (
-	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 
@@ -20,12 +25,8 @@ func CustomValidator[T any](validate func(value T) *string) Validator {
 			switch v := value.(type) {
 			case T:
 				if err := validate(v); err != nil {
-					return internal.NewValidationError(fieldName,
-						utils.GetOptionalStringOrDefault(
-							*err,
-							args...,
-						),
-					)
+					return internal.NewValidationError(fieldName, *err).
+						WithCode(CodeCustom).WithValue(value).WithCustomMessage(args...)
 				}
 			}
 
@@ -34,14 +35,17 @@ func CustomValidator[T any](validate func(value T) *string) Validator {
 	}
 }
 
+// Validates that a string is not blank and that a slice or map is not empty
+// A nil value, i.e. a nil pointer field, fails too, while other types like numbers
+// and booleans always pass, so a zero int is not empty
 func NonEmpty(fieldName string, value any, args ...string) govalid.ValidationFunc {
 	return func() *internal.ValidationError {
-		validationError := internal.NewValidationError(
-			fieldName,
-			utils.GetOptionalStringOrDefault("must not be empty", args...),
-		)
+		validationError := internal.NewValidationError(fieldName, "must not be empty").
+			WithCode(CodeNonEmpty).WithValue(value).WithCustomMessage(args...)
 
 		switch v := value.(type) {
+		case nil:
+			return validationError
 		case string:
 			if strings.TrimSpace(v) == "" {
 				return validationError
@@ -54,6 +58,18 @@ func NonEmpty(fieldName string, value any, args ...string) govalid.ValidationFun
 			if len(v) == 0 {
 				return validationError
 			}
+		default:
+			rv := reflect.ValueOf(v)
+			switch rv.Kind() {
+			case reflect.String:
+				if strings.TrimSpace(rv.String()) == "" {
+					return validationError
+				}
+			case reflect.Slice, reflect.Map:
+				if rv.Len() == 0 {
+					return validationError
+				}
+			}
 		}
 		return nil
 	}
@@ -61,17 +77,14 @@ func NonEmpty(fieldName string, value any, args ...string) govalid.ValidationFun
 
 func Min[T internal.Number](fieldName string, value any, min T, args ...string) govalid.ValidationFunc {
 	return func() *internal.ValidationError {
-		switch v := value.(type) {
-		case T:
-			if v < min {
-				return internal.NewValidationErrorf(
-					fieldName,
-					utils.GetOptionalStringOrDefault(
-						fmt.Sprintf("must be at least %v", min),
-						args...,
-					),
-				)
-			}
+		v, ok := value.(T)
+		if !ok {
+			return internal.NewUnsupportedTypeError(fieldName, value, reflect.TypeFor[T]().String())
+		}
+
+		if v < min {
+			return internal.NewValidationErrorf(fieldName, "must be at least %v", min).
+				WithCode(CodeMin).WithParam("min", min).WithValue(value).WithCustomMessage(args...)
 		}
 		return nil
 	}
@@ -79,35 +92,32 @@ func Min[T internal.Number](fieldName string, value any, min T, args ...string)
 
 func Max[T internal.Number](fieldName string, value any, max T, args ...string) govalid.ValidationFunc {
 	return func() *internal.ValidationError {
-		switch v := value.(type) {
-		case T:
-			if v > max {
-				return internal.NewValidationErrorf(
-					fieldName,
-					utils.GetOptionalStringOrDefault(
-						fmt.Sprintf("must be at most %v", max),
-						args...,
-					),
-				)
-			}
+		v, ok := value.(T)
+		if !ok {
+			return internal.NewUnsupportedTypeError(fieldName, value, reflect.TypeFor[T]().String())
+		}
+
+		if v > max {
+			return internal.NewValidationErrorf(fieldName, "must be at most %v", max).
+				WithCode(CodeMax).WithParam("max", max).WithValue(value).WithCustomMessage(args...)
 		}
 		return nil
 	}
 }
 
+// Kinds of values MinLength and MaxLength can measure
+const lengthTypes = "string, slice, array or map"
+
 func MinLength(fieldName string, value any, min int, args ...string) govalid.ValidationFunc {
 	return func() *internal.ValidationError {
 		length, err := utils.GetLength(value)
 		if err != nil {
-			return internal.NewValidationError(fieldName, err.Error())
+			return internal.NewUnsupportedTypeError(fieldName, value, lengthTypes)
 		}
 
 		if length < min {
-			msg := utils.GetOptionalStringOrDefault(
-				fmt.Sprintf("must be at least %d characters", min),
-				args...,
-			)
-			return internal.NewValidationError(fieldName, msg)
+			return internal.NewValidationErrorf(fieldName, "must be at least %d characters", min).
+				WithCode(CodeMinLength).WithParam("min", min).WithValue(value).WithCustomMessage(args...)
 		}
 		return nil
 	}
@@ -117,15 +127,12 @@ func MaxLength(fieldName string, value any, max int, args ...string) govalid.Val
 	return func() *internal.ValidationError {
 		length, err := utils.GetLength(value)
 		if err != nil {
-			return internal.NewValidationError(fieldName, err.Error())
+			return internal.NewUnsupportedTypeError(fieldName, value, lengthTypes)
 		}
 
 		if length > max {
-			msg := utils.GetOptionalStringOrDefault(
-				fmt.Sprintf("must be at most %d characters", max),
-				args...,
-			)
-			return internal.NewValidationError(fieldName, msg)
+			return internal.NewValidationErrorf(fieldName, "must be at most %d characters", max).
+				WithCode(CodeMaxLength).WithParam("max", max).WithValue(value).WithCustomMessage(args...)
 		}
 		return nil
 	}
@@ -135,13 +142,8 @@ func MatchesRegex(fieldName, value, pattern string, args ...string) govalid.Vali
 	return func() *internal.ValidationError {
 		matched, err := regexp.MatchString(pattern, value)
 		if err != nil || !matched {
-			return internal.NewValidationError(
-				fieldName,
-				utils.GetOptionalStringOrDefault(
-					fmt.Sprintf("must match pattern %s", pattern),
-					args...,
-				),
-			)
+			return internal.NewValidationErrorf(fieldName, "must match pattern %s", pattern).
+				WithCode(CodeMatchesRegex).WithParam("pattern", pattern).WithValue(value).WithCustomMessage(args...)
 		}
 		return nil
 	}
@@ -149,5 +151,11 @@ func MatchesRegex(fieldName, value, pattern string, args ...string) govalid.Vali
 
 func IsEmail(fieldName, value string, args ...string) govalid.ValidationFunc {
 	const emailPattern = `^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`
-	return MatchesRegex(fieldName, value, emailPattern, args...)
+	matchesEmail := MatchesRegex(fieldName, value, emailPattern, args...)
+	return func() *internal.ValidationError {
+		if err := matchesEmail(); err != nil {
+			return err.WithCode(CodeEmail)
+		}
+		return nil
+	}
 }
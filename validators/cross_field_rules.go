@@ -0,0 +1,39 @@
+package validators
+
+import "github.com/Palma99/govalid"
+
+func EqualToRule(otherField string, otherValue any, customMessage ...string) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return EqualTo(field, value, otherField, otherValue, customMessage...)
+	}
+}
+
+func NotEqualToRule(otherField string, otherValue any, customMessage ...string) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return NotEqualTo(field, value, otherField, otherValue, customMessage...)
+	}
+}
+
+func GreaterThanFieldRule(otherField string, otherValue any, customMessage ...string) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return GreaterThanField(field, value, otherField, otherValue, customMessage...)
+	}
+}
+
+func GreaterThanOrEqualFieldRule(otherField string, otherValue any, customMessage ...string) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return GreaterThanOrEqualField(field, value, otherField, otherValue, customMessage...)
+	}
+}
+
+func LessThanFieldRule(otherField string, otherValue any, customMessage ...string) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return LessThanField(field, value, otherField, otherValue, customMessage...)
+	}
+}
+
+func LessThanOrEqualFieldRule(otherField string, otherValue any, customMessage ...string) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return LessThanOrEqualField(field, value, otherField, otherValue, customMessage...)
+	}
+}
@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"reflect"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/internal/utils"
+)
+
+// Compares the value with the value of another field, both must be numbers, strings or time.Time
+// Pointers are dereferenced, the comparison is skipped if either value is nil, i.e. an optional
+// field that is not set, as its presence is checked by required rules
+func compareField(
+	fieldName string, value any,
+	otherField string, otherValue any,
+	code, message string, accept func(comparison int) bool,
+	args ...string,
+) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		a, okA := deref(value)
+		b, okB := deref(otherValue)
+		if !okA || !okB {
+			return nil
+		}
+
+		comparison, err := utils.Compare(a, b)
+		if err != nil {
+			return internal.NewValidationErrorf(fieldName, "can not be compared with %s", otherField).
+				WithCode(CodeUnsupportedType).WithParam("other_field", otherField).WithValue(value)
+		}
+
+		if !accept(comparison) {
+			return internal.NewValidationErrorf(fieldName, "%s %s", message, otherField).
+				WithCode(code).WithParam("other_field", otherField).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is equal to the value of another field, i.e. a password confirmation
+func EqualTo(fieldName string, value any, otherField string, otherValue any, args ...string) govalid.ValidationFunc {
+	return compareField(fieldName, value, otherField, otherValue,
+		CodeEqualToField, "must be equal to", func(c int) bool { return c == 0 }, args...)
+}
+
+func NotEqualTo(fieldName string, value any, otherField string, otherValue any, args ...string) govalid.ValidationFunc {
+	return compareField(fieldName, value, otherField, otherValue,
+		CodeNotEqualToField, "must not be equal to", func(c int) bool { return c != 0 }, args...)
+}
+
+func GreaterThanField(fieldName string, value any, otherField string, otherValue any, args ...string) govalid.ValidationFunc {
+	return compareField(fieldName, value, otherField, otherValue,
+		CodeGreaterThanField, "must be greater than", func(c int) bool { return c > 0 }, args...)
+}
+
+func GreaterThanOrEqualField(fieldName string, value any, otherField string, otherValue any, args ...string) govalid.ValidationFunc {
+	return compareField(fieldName, value, otherField, otherValue,
+		CodeGreaterThanOrEqualField, "must be greater than or equal to", func(c int) bool { return c >= 0 }, args...)
+}
+
+func LessThanField(fieldName string, value any, otherField string, otherValue any, args ...string) govalid.ValidationFunc {
+	return compareField(fieldName, value, otherField, otherValue,
+		CodeLessThanField, "must be less than", func(c int) bool { return c < 0 }, args...)
+}
+
+func LessThanOrEqualField(fieldName string, value any, otherField string, otherValue any, args ...string) govalid.ValidationFunc {
+	return compareField(fieldName, value, otherField, otherValue,
+		CodeLessThanOrEqualField, "must be less than or equal to", func(c int) bool { return c <= 0 }, args...)
+}
+
+// Returns the value a pointer points to, false if the value or the pointer is nil
+func deref(value any) (any, bool) {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		return nil, false
+	}
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, false
+		}
+		rv = rv.Elem()
+	}
+	return rv.Interface(), true
+}
@@ -0,0 +1,23 @@
+package validators
+
+import (
+	"github.com/Palma99/govalid"
+)
+
+func IsCodiceFiscaleRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsCodiceFiscale(field, value, customMessage...)
+	})
+}
+
+func IsPartitaIVARule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsPartitaIVA(field, value, customMessage...)
+	})
+}
+
+func IsEUVATRule(countries []string, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsEUVAT(field, value, countries, customMessage...)
+	})
+}
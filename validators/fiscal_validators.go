@@ -0,0 +1,185 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+)
+
+// Digits of the codice fiscale that can be replaced by letters when two people
+// would have the same code (omocodia), and the letters replacing 0-9
+var (
+	omocodiaPositions = []int{6, 7, 9, 10, 12, 13, 14}
+	omocodiaLetters   = "LMNPQRSTUV"
+)
+
+// Birth month letters, January to December
+const codiceFiscaleMonths = "ABCDEHLMPRST"
+
+// Values of the characters in odd positions (1st, 3rd, ...) for the check character, from A/0 to Z
+var codiceFiscaleOdd = []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+// Checks that the value is an Italian codice fiscale, i.e. RSSMRA85T10A562S
+// Codes with omocodia are accepted, lowercase letters and surrounding spaces are ignored
+// Companies have a numeric codice fiscale, 11 digit values are checked as a partita IVA
+//
+// Errors have the code CodeCodiceFiscale for a malformed code, CodeCodiceFiscaleBirthDate
+// for an impossible birth date and CodeCheckDigit for a wrong check character
+func IsCodiceFiscale(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		code := strings.ToUpper(strings.TrimSpace(value))
+		if len(code) == 11 && isDigits(code) {
+			return IsPartitaIVA(fieldName, value, args...)()
+		}
+
+		decoded, ok := decodeOmocodia(code)
+		if !ok || !isLetters(code[:6]) || !isDigits(decoded[6:8]) || !isLetters(code[11:12]) || !isDigits(decoded[12:15]) || !isLetters(code[15:]) {
+			return internal.NewValidationError(fieldName, "must be a valid codice fiscale").
+				WithCode(CodeCodiceFiscale).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !validBirthDate(decoded[8], decoded[9:11]) {
+			return internal.NewValidationError(fieldName, "has an invalid birth date").
+				WithCode(CodeCodiceFiscaleBirthDate).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if codiceFiscaleCheck(code[:15]) != code[15] {
+			return checkDigitError(fieldName, value, "codice fiscale", args...)
+		}
+		return nil
+	}
+}
+
+// Replaces the omocodia letters with their digits
+// The check character is computed on the original code, so it is not decoded
+func decodeOmocodia(code string) (string, bool) {
+	if len(code) != 16 {
+		return "", false
+	}
+
+	decoded := []byte(code)
+	for _, i := range omocodiaPositions {
+		if j := strings.IndexByte(omocodiaLetters, code[i]); j >= 0 {
+			decoded[i] = byte('0' + j)
+		}
+	}
+	return string(decoded), true
+}
+
+// Women have 40 added to the day of birth, February 29 is always accepted
+// since the century of the year is unknown
+func validBirthDate(month byte, day string) bool {
+	m := strings.IndexByte(codiceFiscaleMonths, month)
+	if m < 0 || !isDigits(day) {
+		return false
+	}
+
+	d := int(day[0]-'0')*10 + int(day[1]-'0')
+	if d > 40 {
+		d -= 40
+	}
+
+	daysInMonth := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	return d >= 1 && d <= daysInMonth[m]
+}
+
+func codiceFiscaleCheck(code string) byte {
+	sum := 0
+	for i := range code {
+		index := int(code[i] - 'A')
+		if code[i] <= '9' {
+			index = int(code[i] - '0')
+		}
+
+		if i%2 == 0 {
+			sum += codiceFiscaleOdd[index]
+		} else {
+			sum += index
+		}
+	}
+	return byte('A' + sum%26)
+}
+
+// Checks that the value is an Italian partita IVA: 11 digits, the last one is a Luhn check digit
+// The value can have the IT prefix, spaces are ignored
+//
+// Errors have the code CodePartitaIVA for a malformed number, CodePartitaIVAOffice
+// for an unknown provincial office code and CodeCheckDigit for a wrong check digit
+func IsPartitaIVA(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		number := strings.TrimPrefix(strings.ToUpper(strings.ReplaceAll(value, " ", "")), "IT")
+		if len(number) != 11 || !isDigits(number) {
+			return internal.NewValidationError(fieldName, "must be a valid partita IVA").
+				WithCode(CodePartitaIVA).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !validPartitaIVAOffice(number[7:10]) {
+			return internal.NewValidationError(fieldName, "has an invalid office code").
+				WithCode(CodePartitaIVAOffice).WithParam("office", number[7:10]).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !validLuhn(number) {
+			return checkDigitError(fieldName, value, "partita IVA", args...)
+		}
+		return nil
+	}
+}
+
+// Offices 001-100 are the provinces, 120 and 121 are used for foreign entities,
+// 888 and 999 are assigned by the Agenzia delle Entrate
+func validPartitaIVAOffice(office string) bool {
+	switch office {
+	case "120", "121", "888", "999":
+		return true
+	}
+	return office >= "001" && office <= "100"
+}
+
+// Checks that the value is an EU VAT number, with the country prefix, i.e. IT00743110157 or DE136695976
+// Greece uses the EL prefix, spaces, dots and hyphens are ignored
+// The format of each member state is checked, with its check digits where defined
+// If countries is not empty the prefix must be one of them
+//
+// Errors have the code CodeVATCountry for a missing or not allowed prefix, CodeVAT for
+// a number not matching the country format and CodeCheckDigit for wrong check digits
+func IsEUVAT(fieldName, value string, countries []string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		number := strings.ToUpper(strings.NewReplacer(" ", "", ".", "", "-", "").Replace(value))
+
+		country := ""
+		if len(number) >= 2 {
+			country = number[:2]
+		}
+		format, ok := vatFormats[country]
+
+		if !ok || !countryAllowed(countries, country) {
+			allowed := strings.Join(countries, ", ")
+			if len(countries) == 0 {
+				allowed = strings.Join(sortedVATCountries(), ", ")
+			}
+			return internal.NewValidationErrorf(fieldName, "must start with one of the country codes %s", allowed).
+				WithCode(CodeVATCountry).WithParam("countries", allowed).WithValue(value).WithCustomMessage(args...)
+		}
+
+		number = number[2:]
+		if !format.pattern.MatchString(number) {
+			return internal.NewValidationErrorf(fieldName, "must be a valid %s VAT number", country).
+				WithCode(CodeVAT).WithParam("country", country).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if format.check != nil && !format.check(number) {
+			return checkDigitError(fieldName, value, country+" VAT number", args...)
+		}
+		return nil
+	}
+}
+
+func isLetters(value string) bool {
+	for _, c := range value {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return value != ""
+}
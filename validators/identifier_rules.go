@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"github.com/Palma99/govalid"
+)
+
+func IsUUIDRule(versions []int, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsUUID(field, value, versions, customMessage...)
+	})
+}
+
+func IsULIDRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsULID(field, value, customMessage...)
+	})
+}
+
+func IsISBNRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsISBN(field, value, customMessage...)
+	})
+}
+
+func IsISBN10Rule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsISBN10(field, value, customMessage...)
+	})
+}
+
+func IsISBN13Rule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsISBN13(field, value, customMessage...)
+	})
+}
+
+func IsEAN8Rule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsEAN8(field, value, customMessage...)
+	})
+}
+
+func IsEAN13Rule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsEAN13(field, value, customMessage...)
+	})
+}
+
+func IsUPCARule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsUPCA(field, value, customMessage...)
+	})
+}
+
+func IsCreditCardRule(brands []CardBrand, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsCreditCard(field, value, brands, customMessage...)
+	})
+}
@@ -0,0 +1,307 @@
+package validators
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+)
+
+// Checks that the value is a UUID in the canonical form, i.e. 123e4567-e89b-12d3-a456-426614174000
+// If versions is not empty the UUID must have one of them and the RFC 9562 variant,
+// otherwise any UUID is accepted, including the nil UUID
+func IsUUID(fieldName, value string, versions []int, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if !isUUID(value) {
+			return internal.NewValidationError(fieldName, "must be a valid UUID").
+				WithCode(CodeUUID).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if len(versions) == 0 {
+			return nil
+		}
+
+		version, _ := strconv.ParseInt(value[14:15], 16, 0)
+		variant, _ := strconv.ParseInt(value[19:20], 16, 0)
+		if !slices.Contains(versions, int(version)) || variant&0xc != 0x8 {
+			allowed := joinInts(versions)
+			return internal.NewValidationErrorf(fieldName, "must be a UUID of version %s", allowed).
+				WithCode(CodeUUIDVersion).WithParam("versions", allowed).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+func isUUID(value string) bool {
+	if len(value) != 36 {
+		return false
+	}
+	for i, c := range value {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if c != '-' {
+				return false
+			}
+		} else if !isHexDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// Crockford's base32 alphabet used by ULIDs, without I, L, O and U
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Checks that the value is a ULID: 26 characters of Crockford's base32, case insensitive
+// The first character is at most 7, larger values overflow the 128 bits of a ULID
+func IsULID(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		valid := len(value) == 26 && value[0] <= '7'
+		for _, c := range strings.ToUpper(value) {
+			valid = valid && strings.ContainsRune(crockfordAlphabet, c)
+		}
+
+		if !valid {
+			return internal.NewValidationError(fieldName, "must be a valid ULID").
+				WithCode(CodeULID).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is an ISBN-10 or an ISBN-13 with a valid check digit
+// Hyphens and spaces are ignored, i.e. 978-88-04-66829-4
+func IsISBN(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if len(stripSeparators(value)) == 10 {
+			return IsISBN10(fieldName, value, args...)()
+		}
+		return IsISBN13(fieldName, value, args...)()
+	}
+}
+
+// Checks that the value is an ISBN-10 with a valid check digit, the last digit can be X
+// Hyphens and spaces are ignored, i.e. 88-04-66829-3
+func IsISBN10(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		isbn := strings.ToUpper(stripSeparators(value))
+		if len(isbn) != 10 || !isDigits(isbn[:9]) || !(isDigits(isbn[9:]) || isbn[9] == 'X') {
+			return internal.NewValidationError(fieldName, "must be a valid ISBN").
+				WithCode(CodeISBN).WithValue(value).WithCustomMessage(args...)
+		}
+
+		sum := 0
+		for i, c := range isbn {
+			digit := int(c - '0')
+			if c == 'X' {
+				digit = 10
+			}
+			sum += (10 - i) * digit
+		}
+
+		if sum%11 != 0 {
+			return checkDigitError(fieldName, value, "ISBN-10", args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is an ISBN-13 with a valid check digit, starting with 978 or 979
+// Hyphens and spaces are ignored, i.e. 978-88-04-66829-4
+func IsISBN13(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		isbn := stripSeparators(value)
+		if len(isbn) != 13 || !isDigits(isbn) || !(strings.HasPrefix(isbn, "978") || strings.HasPrefix(isbn, "979")) {
+			return internal.NewValidationError(fieldName, "must be a valid ISBN").
+				WithCode(CodeISBN).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !validGTIN(isbn) {
+			return checkDigitError(fieldName, value, "ISBN-13", args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is an EAN-8 barcode number with a valid check digit
+func IsEAN8(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return gtin(fieldName, value, 8, CodeEAN8, "EAN-8", args...)
+}
+
+// Checks that the value is an EAN-13 barcode number with a valid check digit
+func IsEAN13(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return gtin(fieldName, value, 13, CodeEAN13, "EAN-13", args...)
+}
+
+// Checks that the value is a UPC-A barcode number with a valid check digit
+func IsUPCA(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return gtin(fieldName, value, 12, CodeUPCA, "UPC-A", args...)
+}
+
+// EAN and UPC numbers are GTINs of different lengths, with the same check digit
+func gtin(fieldName, value string, length int, code, identifier string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if len(value) != length || !isDigits(value) {
+			return internal.NewValidationErrorf(fieldName, "must be a valid %s", identifier).
+				WithCode(code).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !validGTIN(value) {
+			return checkDigitError(fieldName, value, identifier, args...)
+		}
+		return nil
+	}
+}
+
+// From the right, digits are weighted 1 and 3 alternately, the check digit included
+func validGTIN(digits string) bool {
+	sum := 0
+	for i := range digits {
+		digit := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+// CardBrand is the issuing network of a payment card number
+type CardBrand string
+
+const (
+	CardUnknown    CardBrand = ""
+	CardVisa       CardBrand = "visa"
+	CardMastercard CardBrand = "mastercard"
+	CardAmex       CardBrand = "amex"
+	CardDiscover   CardBrand = "discover"
+	CardDiners     CardBrand = "diners"
+	CardJCB        CardBrand = "jcb"
+	CardUnionPay   CardBrand = "unionpay"
+	CardMaestro    CardBrand = "maestro"
+)
+
+type cardRange struct {
+	brand    CardBrand
+	from, to int // Inclusive range of the number prefix, compared on the digits of from
+	lengths  []int
+}
+
+// More specific prefixes come first, i.e. Discover's 622126-622925 before UnionPay's 62
+var cardRanges = []cardRange{
+	{CardAmex, 34, 34, []int{15}},
+	{CardAmex, 37, 37, []int{15}},
+	{CardDiners, 300, 305, []int{14, 15, 16, 17, 18, 19}},
+	{CardDiners, 36, 36, []int{14, 15, 16, 17, 18, 19}},
+	{CardDiners, 38, 39, []int{14, 15, 16, 17, 18, 19}},
+	{CardJCB, 3528, 3589, []int{16, 17, 18, 19}},
+	{CardVisa, 4, 4, []int{13, 16, 19}},
+	{CardMastercard, 51, 55, []int{16}},
+	{CardMastercard, 2221, 2720, []int{16}},
+	{CardDiscover, 6011, 6011, []int{16, 17, 18, 19}},
+	{CardDiscover, 622126, 622925, []int{16, 17, 18, 19}},
+	{CardDiscover, 644, 649, []int{16, 17, 18, 19}},
+	{CardDiscover, 65, 65, []int{16, 17, 18, 19}},
+	{CardUnionPay, 62, 62, []int{16, 17, 18, 19}},
+	{CardMaestro, 50, 50, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{CardMaestro, 56, 69, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// Returns the brand of a card number from its prefix and length, or CardUnknown
+// Spaces and hyphens are ignored, the check digit is not verified
+func CardBrandOf(number string) CardBrand {
+	if brand, ok := findCardRange(stripSeparators(number)); ok {
+		return brand.brand
+	}
+	return CardUnknown
+}
+
+func findCardRange(number string) (cardRange, bool) {
+	for _, r := range cardRanges {
+		digits := len(strconv.Itoa(r.from))
+		if len(number) < digits || !slices.Contains(r.lengths, len(number)) {
+			continue
+		}
+		prefix, err := strconv.Atoi(number[:digits])
+		if err == nil && prefix >= r.from && prefix <= r.to {
+			return r, true
+		}
+	}
+	return cardRange{}, false
+}
+
+// Checks that the value is a payment card number with a valid Luhn check digit
+// Spaces and hyphens are ignored, i.e. 4111 1111 1111 1111
+// If brands is not empty the brand detected from the number must be one of them
+func IsCreditCard(fieldName, value string, brands []CardBrand, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		number := stripSeparators(value)
+		if len(number) < 12 || len(number) > 19 || !isDigits(number) {
+			return internal.NewValidationError(fieldName, "must be a valid card number").
+				WithCode(CodeCreditCard).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !validLuhn(number) {
+			return checkDigitError(fieldName, value, "card number", args...)
+		}
+
+		if len(brands) > 0 && !slices.Contains(brands, CardBrandOf(number)) {
+			allowed := make([]string, len(brands))
+			for i, brand := range brands {
+				allowed[i] = string(brand)
+			}
+			joined := strings.Join(allowed, ", ")
+			return internal.NewValidationErrorf(fieldName, "must be a card of brand %s", joined).
+				WithCode(CodeCardBrand).WithParam("brands", joined).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// From the right, every second digit is doubled, subtracting 9 when it exceeds 9
+func validLuhn(digits string) bool {
+	sum := 0
+	for i := range digits {
+		digit := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+func checkDigitError(fieldName string, value any, identifier string, args ...string) *internal.ValidationError {
+	return internal.NewValidationErrorf(fieldName, "has an invalid %s check digit", identifier).
+		WithCode(CodeCheckDigit).WithParam("identifier", identifier).WithValue(value).WithCustomMessage(args...)
+}
+
+func stripSeparators(value string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(value)
+}
+
+func isDigits(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+func isHexDigit(c rune) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprint(v)
+	}
+	return strings.Join(parts, ", ")
+}
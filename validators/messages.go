@@ -0,0 +1,174 @@
+package validators
+
+import "github.com/Palma99/govalid"
+
+// Built-in messages, registered for use with ValidationResult.Localize
+var (
+	MessagesEN = govalid.MapCatalog{
+		CodeNonEmpty:                "must not be empty",
+		CodeMin:                     "must be at least {min}",
+		CodeMax:                     "must be at most {max}",
+		CodeMinLength:               "must be at least {min} characters",
+		CodeMaxLength:               "must be at most {max} characters",
+		CodeMatchesRegex:            "must match pattern {pattern}",
+		CodeEmail:                   "must be a valid email address",
+		CodeUnsupportedType:         "unsupported type",
+		CodeEqualToField:            "must be equal to {other_field}",
+		CodeNotEqualToField:         "must not be equal to {other_field}",
+		CodeGreaterThanField:        "must be greater than {other_field}",
+		CodeGreaterThanOrEqualField: "must be greater than or equal to {other_field}",
+		CodeLessThanField:           "must be less than {other_field}",
+		CodeLessThanOrEqualField:    "must be less than or equal to {other_field}",
+		CodeURL:                     "must be a valid URL",
+		CodeURLScheme:               "must use one of the schemes {schemes}",
+		CodeHostname:                "must be a valid hostname",
+		CodeIP:                      "must be a valid IP address",
+		CodeIPv4:                    "must be a valid IPv4 address",
+		CodeIPv6:                    "must be a valid IPv6 address",
+		CodeCIDR:                    "must be a valid CIDR range",
+		CodePort:                    "must be a valid port number",
+		CodeMAC:                     "must be a valid MAC address",
+		CodeHostPort:                "must be a valid host:port pair",
+		CodeCheckDigit:              "has an invalid {identifier} check digit",
+		CodeUUID:                    "must be a valid UUID",
+		CodeUUIDVersion:             "must be a UUID of version {versions}",
+		CodeULID:                    "must be a valid ULID",
+		CodeISBN:                    "must be a valid ISBN",
+		CodeEAN8:                    "must be a valid EAN-8",
+		CodeEAN13:                   "must be a valid EAN-13",
+		CodeUPCA:                    "must be a valid UPC-A",
+		CodeCreditCard:              "must be a valid card number",
+		CodeCardBrand:               "must be a card of brand {brands}",
+		CodeCodiceFiscale:           "must be a valid codice fiscale",
+		CodeCodiceFiscaleBirthDate:  "has an invalid birth date",
+		CodePartitaIVA:              "must be a valid partita IVA",
+		CodePartitaIVAOffice:        "has an invalid office code",
+		CodeVAT:                     "must be a valid {country} VAT number",
+		CodeVATCountry:              "must start with one of the country codes {countries}",
+		CodeIBAN:                    "must be a valid {country} IBAN",
+		CodeIBANCountry:             "must be an IBAN of one of the countries {countries}",
+		CodeIBANLength:              "must be {length} characters long for {country}",
+		CodeBIC:                     "must be a valid BIC",
+		CodeBICCountry:              "must be a BIC of one of the countries {countries}",
+		CodePhone:                   "must be a valid phone number",
+		CodePhoneCountry:            "must be a phone number of one of the countries {countries}",
+		CodePhoneLength:             "has an invalid length for a phone number",
+		CodePhoneType:               "must be a phone number of type {types}",
+		CodePostalCode:              "must be a valid {country} postal code, i.e. {example}",
+		CodePostalCodeCountry:       "has an invalid country code {country}",
+	}
+
+	MessagesIT = govalid.MapCatalog{
+		CodeNonEmpty:                "non deve essere vuoto",
+		CodeMin:                     "deve essere almeno {min}",
+		CodeMax:                     "deve essere al massimo {max}",
+		CodeMinLength:               "deve contenere almeno {min} caratteri",
+		CodeMaxLength:               "deve contenere al massimo {max} caratteri",
+		CodeMatchesRegex:            "deve corrispondere al pattern {pattern}",
+		CodeEmail:                   "deve essere un indirizzo email valido",
+		CodeUnsupportedType:         "tipo non supportato",
+		CodeEqualToField:            "deve essere uguale a {other_field}",
+		CodeNotEqualToField:         "deve essere diverso da {other_field}",
+		CodeGreaterThanField:        "deve essere maggiore di {other_field}",
+		CodeGreaterThanOrEqualField: "deve essere maggiore o uguale a {other_field}",
+		CodeLessThanField:           "deve essere minore di {other_field}",
+		CodeLessThanOrEqualField:    "deve essere minore o uguale a {other_field}",
+		CodeURL:                     "deve essere un URL valido",
+		CodeURLScheme:               "deve usare uno degli schemi {schemes}",
+		CodeHostname:                "deve essere un hostname valido",
+		CodeIP:                      "deve essere un indirizzo IP valido",
+		CodeIPv4:                    "deve essere un indirizzo IPv4 valido",
+		CodeIPv6:                    "deve essere un indirizzo IPv6 valido",
+		CodeCIDR:                    "deve essere un intervallo CIDR valido",
+		CodePort:                    "deve essere un numero di porta valido",
+		CodeMAC:                     "deve essere un indirizzo MAC valido",
+		CodeHostPort:                "deve essere una coppia host:porta valida",
+		CodeCheckDigit:              "ha una cifra di controllo {identifier} non valida",
+		CodeUUID:                    "deve essere un UUID valido",
+		CodeUUIDVersion:             "deve essere un UUID di versione {versions}",
+		CodeULID:                    "deve essere un ULID valido",
+		CodeISBN:                    "deve essere un ISBN valido",
+		CodeEAN8:                    "deve essere un EAN-8 valido",
+		CodeEAN13:                   "deve essere un EAN-13 valido",
+		CodeUPCA:                    "deve essere un UPC-A valido",
+		CodeCreditCard:              "deve essere un numero di carta valido",
+		CodeCardBrand:               "deve essere una carta del circuito {brands}",
+		CodeCodiceFiscale:           "deve essere un codice fiscale valido",
+		CodeCodiceFiscaleBirthDate:  "ha una data di nascita non valida",
+		CodePartitaIVA:              "deve essere una partita IVA valida",
+		CodePartitaIVAOffice:        "ha un codice ufficio non valido",
+		CodeVAT:                     "deve essere una partita IVA {country} valida",
+		CodeVATCountry:              "deve iniziare con uno dei codici paese {countries}",
+		CodeIBAN:                    "deve essere un IBAN {country} valido",
+		CodeIBANCountry:             "deve essere un IBAN di uno dei paesi {countries}",
+		CodeIBANLength:              "deve contenere {length} caratteri per {country}",
+		CodeBIC:                     "deve essere un BIC valido",
+		CodeBICCountry:              "deve essere un BIC di uno dei paesi {countries}",
+		CodePhone:                   "deve essere un numero di telefono valido",
+		CodePhoneCountry:            "deve essere un numero di telefono di uno dei paesi {countries}",
+		CodePhoneLength:             "ha una lunghezza non valida per un numero di telefono",
+		CodePhoneType:               "deve essere un numero di telefono di tipo {types}",
+		CodePostalCode:              "deve essere un codice postale {country} valido, ad esempio {example}",
+		CodePostalCodeCountry:       "ha un codice paese non valido {country}",
+	}
+
+	MessagesDE = govalid.MapCatalog{
+		CodeNonEmpty:                "darf nicht leer sein",
+		CodeMin:                     "muss mindestens {min} sein",
+		CodeMax:                     "darf höchstens {max} sein",
+		CodeMinLength:               "muss mindestens {min} Zeichen lang sein",
+		CodeMaxLength:               "darf höchstens {max} Zeichen lang sein",
+		CodeMatchesRegex:            "muss dem Muster {pattern} entsprechen",
+		CodeEmail:                   "muss eine gültige E-Mail-Adresse sein",
+		CodeUnsupportedType:         "nicht unterstützter Typ",
+		CodeEqualToField:            "muss gleich {other_field} sein",
+		CodeNotEqualToField:         "darf nicht gleich {other_field} sein",
+		CodeGreaterThanField:        "muss größer als {other_field} sein",
+		CodeGreaterThanOrEqualField: "muss größer oder gleich {other_field} sein",
+		CodeLessThanField:           "muss kleiner als {other_field} sein",
+		CodeLessThanOrEqualField:    "muss kleiner oder gleich {other_field} sein",
+		CodeURL:                     "muss eine gültige URL sein",
+		CodeURLScheme:               "muss eines der Schemata {schemes} verwenden",
+		CodeHostname:                "muss ein gültiger Hostname sein",
+		CodeIP:                      "muss eine gültige IP-Adresse sein",
+		CodeIPv4:                    "muss eine gültige IPv4-Adresse sein",
+		CodeIPv6:                    "muss eine gültige IPv6-Adresse sein",
+		CodeCIDR:                    "muss ein gültiger CIDR-Bereich sein",
+		CodePort:                    "muss eine gültige Portnummer sein",
+		CodeMAC:                     "muss eine gültige MAC-Adresse sein",
+		CodeHostPort:                "muss ein gültiges Host:Port-Paar sein",
+		CodeCheckDigit:              "hat eine ungültige {identifier}-Prüfziffer",
+		CodeUUID:                    "muss eine gültige UUID sein",
+		CodeUUIDVersion:             "muss eine UUID der Version {versions} sein",
+		CodeULID:                    "muss eine gültige ULID sein",
+		CodeISBN:                    "muss eine gültige ISBN sein",
+		CodeEAN8:                    "muss eine gültige EAN-8 sein",
+		CodeEAN13:                   "muss eine gültige EAN-13 sein",
+		CodeUPCA:                    "muss eine gültige UPC-A sein",
+		CodeCreditCard:              "muss eine gültige Kartennummer sein",
+		CodeCardBrand:               "muss eine Karte der Marke {brands} sein",
+		CodeCodiceFiscale:           "muss ein gültiger Codice Fiscale sein",
+		CodeCodiceFiscaleBirthDate:  "hat ein ungültiges Geburtsdatum",
+		CodePartitaIVA:              "muss eine gültige Partita IVA sein",
+		CodePartitaIVAOffice:        "hat einen ungültigen Amtscode",
+		CodeVAT:                     "muss eine gültige {country}-USt-IdNr. sein",
+		CodeVATCountry:              "muss mit einem der Ländercodes {countries} beginnen",
+		CodeIBAN:                    "muss eine gültige {country}-IBAN sein",
+		CodeIBANCountry:             "muss eine IBAN eines der Länder {countries} sein",
+		CodeIBANLength:              "muss für {country} {length} Zeichen lang sein",
+		CodeBIC:                     "muss ein gültiger BIC sein",
+		CodeBICCountry:              "muss ein BIC eines der Länder {countries} sein",
+		CodePhone:                   "muss eine gültige Telefonnummer sein",
+		CodePhoneCountry:            "muss eine Telefonnummer eines der Länder {countries} sein",
+		CodePhoneLength:             "hat eine ungültige Länge für eine Telefonnummer",
+		CodePhoneType:               "muss eine Telefonnummer vom Typ {types} sein",
+		CodePostalCode:              "muss eine gültige {country}-Postleitzahl sein, z. B. {example}",
+		CodePostalCodeCountry:       "hat einen ungültigen Ländercode {country}",
+	}
+)
+
+func init() {
+	govalid.RegisterCatalog("en", MessagesEN)
+	govalid.RegisterCatalog("it", MessagesIT)
+	govalid.RegisterCatalog("de", MessagesDE)
+}
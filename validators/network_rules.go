@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"github.com/Palma99/govalid"
+)
+
+func IsURLRule(schemes []string, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsURL(field, value, schemes, customMessage...)
+	})
+}
+
+func IsHostnameRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsHostname(field, value, customMessage...)
+	})
+}
+
+func IsIPRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsIP(field, value, customMessage...)
+	})
+}
+
+func IsIPv4Rule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsIPv4(field, value, customMessage...)
+	})
+}
+
+func IsIPv6Rule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsIPv6(field, value, customMessage...)
+	})
+}
+
+func IsCIDRRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsCIDR(field, value, customMessage...)
+	})
+}
+
+func IsPortRule(customMessage ...string) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return IsPort(field, value, customMessage...)
+	}
+}
+
+func IsMACRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsMAC(field, value, customMessage...)
+	})
+}
+
+func IsHostPortRule(customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsHostPort(field, value, customMessage...)
+	})
+}
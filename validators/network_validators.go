@@ -0,0 +1,185 @@
+package validators
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/internal/utils"
+)
+
+// Checks that the value is an absolute URL, with a scheme and a host
+// If schemes is not empty the scheme must be one of them, i.e. []string{"https"}
+func IsURL(fieldName, value string, schemes []string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return internal.NewValidationError(fieldName, "must be a valid URL").
+				WithCode(CodeURL).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if len(schemes) > 0 && !slices.ContainsFunc(schemes, func(scheme string) bool { return strings.EqualFold(scheme, u.Scheme) }) {
+			allowed := strings.Join(schemes, ", ")
+			return internal.NewValidationErrorf(fieldName, "must use one of the schemes %s", allowed).
+				WithCode(CodeURLScheme).WithParam("schemes", allowed).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is a hostname as defined by RFC 1123: dot-separated labels of
+// letters, digits and hyphens, at most 63 characters each and 253 in total
+// A trailing dot is allowed, addresses like 192.168.0.1 are not hostnames
+func IsHostname(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if !isHostname(value) {
+			return internal.NewValidationError(fieldName, "must be a valid hostname").
+				WithCode(CodeHostname).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+func isHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+	if value == "" || len(value) > 253 {
+		return false
+	}
+
+	labels := strings.Split(value, ".")
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	// RFC 1123 requires the top-level label to be alphabetic, so IPv4 addresses are not hostnames
+	_, err := strconv.Atoi(labels[len(labels)-1])
+	return err != nil
+}
+
+// Checks that the value is an IPv4 or IPv6 address
+func IsIP(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if _, err := netip.ParseAddr(value); err != nil {
+			return internal.NewValidationError(fieldName, "must be a valid IP address").
+				WithCode(CodeIP).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is an IPv4 address in dotted decimal notation, i.e. 192.168.0.1
+func IsIPv4(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if addr, err := netip.ParseAddr(value); err != nil || !addr.Is4() {
+			return internal.NewValidationError(fieldName, "must be a valid IPv4 address").
+				WithCode(CodeIPv4).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is an IPv6 address, i.e. 2001:db8::1
+func IsIPv6(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if addr, err := netip.ParseAddr(value); err != nil || !addr.Is6() {
+			return internal.NewValidationError(fieldName, "must be a valid IPv6 address").
+				WithCode(CodeIPv6).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is an IP range in CIDR notation, i.e. 10.0.0.0/8 or 2001:db8::/32
+func IsCIDR(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if _, err := netip.ParsePrefix(value); err != nil {
+			return internal.NewValidationError(fieldName, "must be a valid CIDR range").
+				WithCode(CodeCIDR).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is a port number between 1 and 65535, as an integer of any type or a string
+// Values of other types fail with a CodeUnsupportedType error
+func IsPort(fieldName string, value any, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		var port float64
+		switch v := utils.NormalizeString(value).(type) {
+		case string:
+			parsed, err := strconv.ParseUint(v, 10, 16)
+			if err != nil {
+				parsed = 0
+			}
+			port = float64(parsed)
+		default:
+			number, ok := utils.ToFloat64(v)
+			if !ok {
+				return internal.NewUnsupportedTypeError(fieldName, value, "integer or string")
+			}
+			if number != float64(int64(number)) {
+				number = 0
+			}
+			port = number
+		}
+
+		if port < 1 || port > 65535 {
+			return internal.NewValidationError(fieldName, "must be a valid port number").
+				WithCode(CodePort).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is a MAC address, i.e. 00:1a:2b:3c:4d:5e, 00-1A-2B-3C-4D-5E or 001a.2b3c.4d5e
+// EUI-64 and 20-octet InfiniBand addresses are accepted too
+func IsMAC(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if _, err := net.ParseMAC(value); err != nil {
+			return internal.NewValidationError(fieldName, "must be a valid MAC address").
+				WithCode(CodeMAC).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+// Checks that the value is a host and a port, i.e. example.com:443, 10.0.0.1:8080 or [2001:db8::1]:443
+// The host must be a hostname or an IP address, IPv6 addresses must be in brackets
+func IsHostPort(fieldName, value string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		if !isHostPort(value) {
+			return internal.NewValidationError(fieldName, "must be a valid host:port pair").
+				WithCode(CodeHostPort).WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
+
+func isHostPort(value string) bool {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return false
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return false
+	}
+
+	// SplitHostPort rejects IPv6 addresses without brackets
+	if _, err := netip.ParseAddr(host); err == nil {
+		return true
+	}
+	return isHostname(host)
+}
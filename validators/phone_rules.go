@@ -0,0 +1,11 @@
+package validators
+
+import (
+	"github.com/Palma99/govalid"
+)
+
+func IsPhoneRule(opts PhoneOptions, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return IsPhone(field, value, opts, customMessage...)
+	})
+}
@@ -0,0 +1,272 @@
+package validators
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+)
+
+// PhoneType is the kind of line of a phone number
+type PhoneType string
+
+const (
+	PhoneFixed    PhoneType = "fixed"
+	PhoneMobile   PhoneType = "mobile"
+	PhoneTollFree PhoneType = "toll_free"
+	// Countries like the US do not distinguish fixed and mobile numbers,
+	// these numbers are accepted when either type is allowed
+	PhoneFixedOrMobile PhoneType = "fixed_or_mobile"
+)
+
+// Types are matched in this order, more specific patterns first
+var phoneTypes = []PhoneType{PhoneTollFree, PhoneMobile, PhoneFixed, PhoneFixedOrMobile}
+
+var (
+	// ErrPhoneFormat is returned for values that are not phone numbers, i.e. with letters
+	ErrPhoneFormat = errors.New("invalid phone number format")
+	// ErrPhoneCountry is returned for unknown calling codes, or national numbers without a country
+	ErrPhoneCountry = errors.New("unknown phone number country")
+	// ErrPhoneLength is returned for numbers too short or too long for their country
+	ErrPhoneLength = errors.New("invalid phone number length")
+	// ErrPhoneNumber is returned for numbers with a prefix that is not used in their country
+	ErrPhoneNumber = errors.New("invalid phone number")
+)
+
+// PhoneNumber is a parsed phone number
+type PhoneNumber struct {
+	// ISO 3166 code of the country, i.e. "IT"
+	Country string
+	// Country calling code, i.e. "39"
+	CallingCode string
+	// National significant number, without the trunk prefix, i.e. "0612345678"
+	National string
+	Type     PhoneType
+}
+
+// Returns the number in E.164 form, i.e. +390612345678
+func (p PhoneNumber) E164() string {
+	return "+" + p.CallingCode + p.National
+}
+
+type phoneCountry struct {
+	Code    string               `json:"code"`
+	Trunk   string               `json:"trunk"`
+	Lengths []int                `json:"lengths"`
+	Types   map[PhoneType]string `json:"types"`
+
+	country  string
+	patterns map[PhoneType]*regexp.Regexp
+}
+
+// Calling codes, trunk prefixes, lengths and patterns of the national numbers of each country
+//
+//go:embed data/phone_metadata.json
+var phoneMetadataJSON []byte
+
+var (
+	phoneCountries    map[string]*phoneCountry
+	phoneCallingCodes map[string][]*phoneCountry
+)
+
+func init() {
+	if err := json.Unmarshal(phoneMetadataJSON, &phoneCountries); err != nil {
+		panic(fmt.Sprintf("validators: phone metadata: %v", err))
+	}
+
+	phoneCallingCodes = map[string][]*phoneCountry{}
+	for country, metadata := range phoneCountries {
+		metadata.country = country
+		metadata.patterns = make(map[PhoneType]*regexp.Regexp, len(metadata.Types))
+		for phoneType, pattern := range metadata.Types {
+			metadata.patterns[phoneType] = regexp.MustCompile(`^(` + pattern + `)$`)
+		}
+		phoneCallingCodes[metadata.Code] = append(phoneCallingCodes[metadata.Code], metadata)
+	}
+
+	// Countries sharing a calling code are tried in alphabetical order, i.e. CA matches
+	// its own area codes before US matches the others
+	for _, countries := range phoneCallingCodes {
+		sort.Slice(countries, func(i, j int) bool { return countries[i].country < countries[j].country })
+	}
+}
+
+// Parses a phone number in international form, i.e. +39 06 1234 5678 or 0039 06 1234 5678,
+// or in national form, i.e. 06 1234 5678, for the defaultCountry
+// Spaces, hyphens, dots, slashes and parentheses are ignored
+// If defaultCountry is empty, numbers in national form fail with ErrPhoneCountry
+// Toll-free numbers shared by several countries, like the NANP ones, get the defaultCountry
+// if it is one of them, otherwise the first one alphabetically
+//
+// The errors wrap ErrPhoneFormat, ErrPhoneCountry, ErrPhoneLength or ErrPhoneNumber
+func ParsePhone(value, defaultCountry string) (PhoneNumber, error) {
+	return parsePhone(value, defaultCountry, []string{defaultCountry})
+}
+
+// Returns a phone number in E.164 form, i.e. +390612345678, to store numbers in one form
+// It parses the number as ParsePhone does and returns its errors
+func NormalizePhone(value, defaultCountry string) (string, error) {
+	phone, err := ParsePhone(value, defaultCountry)
+	if err != nil {
+		return "", err
+	}
+	return phone.E164(), nil
+}
+
+// Parses a phone number, a shared toll-free number gets one of the preferred countries if possible
+func parsePhone(value, defaultCountry string, preferred []string) (PhoneNumber, error) {
+	digits := strings.NewReplacer(" ", "", "-", "", ".", "", "/", "", "(", "", ")", "").Replace(strings.TrimSpace(value))
+
+	international := false
+	if rest, ok := strings.CutPrefix(digits, "+"); ok {
+		digits, international = rest, true
+	} else if rest, ok := strings.CutPrefix(digits, "00"); ok {
+		digits, international = rest, true
+	}
+
+	// E.164 numbers have at most 15 digits
+	if !isDigits(digits) || len(digits) > 15 {
+		return PhoneNumber{}, fmt.Errorf("%w: %q", ErrPhoneFormat, value)
+	}
+
+	if !international {
+		metadata, ok := phoneCountries[strings.ToUpper(defaultCountry)]
+		if !ok {
+			return PhoneNumber{}, fmt.Errorf("%w: national number without a known default country", ErrPhoneCountry)
+		}
+		return matchPhone([]*phoneCountry{metadata}, digits, preferred)
+	}
+
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		if countries, ok := phoneCallingCodes[digits[:i]]; ok {
+			return matchPhone(countries, digits[i:], preferred)
+		}
+	}
+	return PhoneNumber{}, fmt.Errorf("%w: unknown calling code in %q", ErrPhoneCountry, value)
+}
+
+func matchPhone(countries []*phoneCountry, national string, preferred []string) (PhoneNumber, error) {
+	validLength := false
+	var matches []PhoneNumber
+
+	for _, metadata := range countries {
+		number := national
+		// The trunk prefix is dialed in national form, and often written in international form too,
+		// i.e. +44 (0)20 7946 0000
+		if trimmed, ok := strings.CutPrefix(number, metadata.Trunk); ok && metadata.Trunk != "" && slices.Contains(metadata.Lengths, len(trimmed)) {
+			number = trimmed
+		}
+
+		if !slices.Contains(metadata.Lengths, len(number)) {
+			continue
+		}
+		validLength = true
+
+		for _, phoneType := range phoneTypes {
+			if pattern, ok := metadata.patterns[phoneType]; ok && pattern.MatchString(number) {
+				matches = append(matches, PhoneNumber{Country: metadata.country, CallingCode: metadata.Code, National: number, Type: phoneType})
+				break
+			}
+		}
+	}
+
+	if len(matches) > 0 {
+		// Toll-free numbers are not geographic, so they belong to every country matching them,
+		// while the other numbers belong to the first one, i.e. CA before the catch-all US patterns
+		if matches[0].Type == PhoneTollFree {
+			for _, match := range matches {
+				if match.Type == PhoneTollFree && slices.ContainsFunc(preferred, func(c string) bool { return strings.EqualFold(c, match.Country) }) {
+					return match, nil
+				}
+			}
+		}
+		return matches[0], nil
+	}
+
+	if !validLength {
+		return PhoneNumber{}, fmt.Errorf("%w: %s has %d digits", ErrPhoneLength, national, len(national))
+	}
+	return PhoneNumber{}, fmt.Errorf("%w: %s", ErrPhoneNumber, national)
+}
+
+// PhoneOptions configures IsPhone, the zero value accepts any number in international form
+type PhoneOptions struct {
+	// Country of numbers in national form, i.e. "IT"
+	DefaultCountry string
+	// Allowed countries, any if empty
+	Countries []string
+	// Allowed types, any if empty
+	Types []PhoneType
+}
+
+// Checks that the value is a phone number of a country in the embedded metadata, see ParsePhone
+// NormalizePhone returns the number in E.164 form once it is valid
+//
+// Errors have the code CodePhone for a malformed number or a prefix not used in the country,
+// CodePhoneCountry for an unknown or not allowed country, CodePhoneLength for a wrong
+// length and CodePhoneType for a type that is not allowed
+func IsPhone(fieldName, value string, opts PhoneOptions, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		// A toll-free number shared by several countries gets an allowed one
+		preferred := opts.Countries
+		if len(preferred) == 0 {
+			preferred = []string{opts.DefaultCountry}
+		}
+		phone, err := parsePhone(value, opts.DefaultCountry, preferred)
+
+		switch {
+		case errors.Is(err, ErrPhoneCountry):
+			return phoneCountryError(fieldName, value, opts.Countries, args...)
+		case errors.Is(err, ErrPhoneLength):
+			return internal.NewValidationError(fieldName, "has an invalid length for a phone number").
+				WithCode(CodePhoneLength).WithValue(value).WithCustomMessage(args...)
+		case err != nil:
+			return internal.NewValidationError(fieldName, "must be a valid phone number").
+				WithCode(CodePhone).WithValue(value).WithCustomMessage(args...)
+		}
+
+		if !countryAllowed(opts.Countries, phone.Country) {
+			return phoneCountryError(fieldName, value, opts.Countries, args...)
+		}
+
+		if len(opts.Types) > 0 && !phoneTypeAllowed(opts.Types, phone.Type) {
+			allowed := make([]string, len(opts.Types))
+			for i, phoneType := range opts.Types {
+				allowed[i] = string(phoneType)
+			}
+			joined := strings.Join(allowed, ", ")
+			return internal.NewValidationErrorf(fieldName, "must be a phone number of type %s", joined).
+				WithCode(CodePhoneType).WithParam("types", joined).WithValue(value).WithCustomMessage(args...)
+		}
+
+		return nil
+	}
+}
+
+func phoneCountryError(fieldName, value string, countries []string, args ...string) *internal.ValidationError {
+	if len(countries) == 0 {
+		countries = make([]string, 0, len(phoneCountries))
+		for country := range phoneCountries {
+			countries = append(countries, country)
+		}
+		sort.Strings(countries)
+	}
+
+	allowed := strings.Join(countries, ", ")
+	return internal.NewValidationErrorf(fieldName, "must be a phone number of one of the countries %s", allowed).
+		WithCode(CodePhoneCountry).WithParam("countries", allowed).WithValue(value).WithCustomMessage(args...)
+}
+
+func phoneTypeAllowed(types []PhoneType, phoneType PhoneType) bool {
+	if phoneType == PhoneFixedOrMobile {
+		return slices.Contains(types, PhoneFixed) || slices.Contains(types, PhoneMobile) || slices.Contains(types, PhoneFixedOrMobile)
+	}
+	return slices.Contains(types, phoneType)
+}
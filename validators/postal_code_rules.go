@@ -0,0 +1,40 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/internal/utils"
+)
+
+// Checks postal codes of a fixed country, see PostalCodeFieldRule to read it from another field
+func PostalCodeRule(country string, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return PostalCode(field, value, country, customMessage...)
+	})
+}
+
+// The country is the value of another field, i.e. PostalCodeFieldRule("country", address.Country)
+// Pointers are dereferenced and a nil country is not checked, errors have the other field
+// name as "country_field" parameter
+func PostalCodeFieldRule(countryField string, country any, customMessage ...string) govalid.ValidationRule {
+	return govalid.AnyRule(func(field string, value string) govalid.ValidationFunc {
+		return func() *internal.ValidationError {
+			other, ok := deref(country)
+			if !ok {
+				return nil
+			}
+
+			code, isString := utils.NormalizeString(other).(string)
+			if !isString {
+				code = fmt.Sprint(other)
+			}
+
+			if err := PostalCode(field, value, code, customMessage...)(); err != nil {
+				return err.WithParam("country_field", countryField)
+			}
+			return nil
+		}
+	})
+}
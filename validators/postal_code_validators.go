@@ -0,0 +1,70 @@
+package validators
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+)
+
+type postalCodeFormat struct {
+	Pattern string `json:"pattern"`
+	// Shown in error messages, i.e. "00184"
+	Example string `json:"example"`
+
+	pattern *regexp.Regexp
+}
+
+// Postal code patterns and examples of each country
+//
+//go:embed data/postal_codes.json
+var postalCodesJSON []byte
+
+var postalCodeFormats map[string]*postalCodeFormat
+
+func init() {
+	if err := json.Unmarshal(postalCodesJSON, &postalCodeFormats); err != nil {
+		panic(fmt.Sprintf("validators: postal codes: %v", err))
+	}
+
+	for _, format := range postalCodeFormats {
+		format.pattern = regexp.MustCompile(`^(` + format.Pattern + `)$`)
+	}
+}
+
+// Checks that the value is a postal code of the country, an ISO 3166 code like "IT"
+// Lowercase letters and surrounding spaces are ignored
+// Countries that are not in the embedded table are not checked, nor is the value if country
+// is empty, so a required country is validated on its own field
+//
+// Errors have the code CodePostalCode with the country and an example, and
+// CodePostalCodeCountry if the country is not a two letter code
+func PostalCode(fieldName, value, country string, args ...string) govalid.ValidationFunc {
+	return func() *internal.ValidationError {
+		code := strings.ToUpper(strings.TrimSpace(country))
+		if code == "" {
+			return nil
+		}
+
+		if len(code) != 2 || !isLetters(code) {
+			return internal.NewValidationErrorf(fieldName, "has an invalid country code %s", code).
+				WithCode(CodePostalCodeCountry).WithParam("country", code).WithValue(value).WithCustomMessage(args...)
+		}
+
+		format, ok := postalCodeFormats[code]
+		if !ok {
+			return nil
+		}
+
+		if !format.pattern.MatchString(strings.ToUpper(strings.TrimSpace(value))) {
+			return internal.NewValidationErrorf(fieldName, "must be a valid %s postal code, i.e. %s", code, format.Example).
+				WithCode(CodePostalCode).WithParam("country", code).WithParam("example", format.Example).
+				WithValue(value).WithCustomMessage(args...)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,262 @@
+package validators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/internal/utils"
+)
+
+// Registers the built-in rules in govalid.DefaultRegistry, so they can be
+// referenced by name in struct tags and schema files
+//
+//	required                     NonEmptyRule, nil values fail, zero numbers and false pass
+//	min_len=n                    MinLengthRule
+//	max_len=n                    MaxLengthRule
+//	min=n                        MinRule, works with any numeric type, other types fail
+//	max=n                        MaxRule, works with any numeric type, other types fail
+//	matches=re                   MatchesRegexRule
+//	email                        IsEmailRule
+//	required_if=Field value      RequiredIf, when the other field equals value
+//	required_unless=Field value  RequiredUnless, when the other field equals value
+//	eq_field=Field               EqualToRule
+//	ne_field=Field               NotEqualToRule
+//	gt_field=Field               GreaterThanFieldRule
+//	gte_field=Field              GreaterThanOrEqualFieldRule
+//	lt_field=Field               LessThanFieldRule
+//	lte_field=Field              LessThanOrEqualFieldRule
+//	url, url=scheme,...          IsURLRule
+//	hostname                     IsHostnameRule
+//	ip, ipv4, ipv6               IsIPRule, IsIPv4Rule, IsIPv6Rule
+//	cidr                         IsCIDRRule
+//	port                         IsPortRule
+//	mac                          IsMACRule
+//	host_port                    IsHostPortRule
+//	uuid, uuid=version,...       IsUUIDRule
+//	ulid                         IsULIDRule
+//	isbn, isbn10, isbn13         IsISBNRule, IsISBN10Rule, IsISBN13Rule
+//	ean8, ean13, upc_a           IsEAN8Rule, IsEAN13Rule, IsUPCARule
+//	credit_card=brand,...        IsCreditCardRule, brands are optional
+//	codice_fiscale               IsCodiceFiscaleRule
+//	partita_iva                  IsPartitaIVARule
+//	eu_vat=country,...           IsEUVATRule, countries are optional
+//	iban=country,...             IsIBANRule, countries are optional, "sepa" for SEPACountries
+//	bic=country,...              IsBICRule, countries are optional
+//	phone=country                IsPhoneRule, country of numbers in national form is optional
+//	mobile_phone=country         IsPhoneRule accepting mobile numbers only
+//	postal_code=Field            PostalCodeFieldRule, the country is the value of the other field
+func init() {
+	registry := govalid.DefaultRegistry
+
+	registry.MustRegister("required", func(govalid.Params) (govalid.ValidationRule, error) {
+		return NonEmptyRule(), nil
+	})
+
+	registry.MustRegister("min_len", func(params govalid.Params) (govalid.ValidationRule, error) {
+		min, err := params.Int()
+		if err != nil {
+			return nil, err
+		}
+		return MinLengthRule(min), nil
+	})
+
+	registry.MustRegister("max_len", func(params govalid.Params) (govalid.ValidationRule, error) {
+		max, err := params.Int()
+		if err != nil {
+			return nil, err
+		}
+		return MaxLengthRule(max), nil
+	})
+
+	registry.MustRegister("min", func(params govalid.Params) (govalid.ValidationRule, error) {
+		min, err := params.Float()
+		if err != nil {
+			return nil, err
+		}
+		return numericRule(func(field string, value float64) govalid.ValidationFunc {
+			return Min(field, value, min)
+		}), nil
+	})
+
+	registry.MustRegister("max", func(params govalid.Params) (govalid.ValidationRule, error) {
+		max, err := params.Float()
+		if err != nil {
+			return nil, err
+		}
+		return numericRule(func(field string, value float64) govalid.ValidationFunc {
+			return Max(field, value, max)
+		}), nil
+	})
+
+	registry.MustRegister("matches", func(params govalid.Params) (govalid.ValidationRule, error) {
+		return stringRule(MatchesRegexRule(params.Raw())), nil
+	})
+
+	registry.MustRegister("email", func(govalid.Params) (govalid.ValidationRule, error) {
+		return stringRule(IsEmailRule()), nil
+	})
+
+	registry.MustRegister("required_if", func(params govalid.Params) (govalid.ValidationRule, error) {
+		predicate, err := fieldEquals(params)
+		if err != nil {
+			return nil, err
+		}
+		return RequiredIf(predicate), nil
+	})
+
+	registry.MustRegister("required_unless", func(params govalid.Params) (govalid.ValidationRule, error) {
+		predicate, err := fieldEquals(params)
+		if err != nil {
+			return nil, err
+		}
+		return RequiredUnless(predicate), nil
+	})
+
+	crossFieldRules := map[string]func(otherField string, otherValue any, customMessage ...string) govalid.ValidationRule{
+		"eq_field":  EqualToRule,
+		"ne_field":  NotEqualToRule,
+		"gt_field":  GreaterThanFieldRule,
+		"gte_field": GreaterThanOrEqualFieldRule,
+		"lt_field":  LessThanFieldRule,
+		"lte_field": LessThanOrEqualFieldRule,
+	}
+	for name, rule := range crossFieldRules {
+		registry.MustRegister(name, func(params govalid.Params) (govalid.ValidationRule, error) {
+			otherField := params.Raw()
+			other, ok := params.Lookup(otherField)
+			if !ok {
+				return nil, fmt.Errorf("unknown field %s", otherField)
+			}
+			return rule(otherField, other), nil
+		})
+	}
+
+	registry.MustRegister("url", func(params govalid.Params) (govalid.ValidationRule, error) {
+		var schemes []string
+		if params.Raw() != "" {
+			schemes = params.List()
+		}
+		return stringRule(IsURLRule(schemes)), nil
+	})
+
+	registry.MustRegister("port", func(govalid.Params) (govalid.ValidationRule, error) {
+		return IsPortRule(), nil
+	})
+
+	registry.MustRegister("uuid", func(params govalid.Params) (govalid.ValidationRule, error) {
+		var versions []int
+		for _, version := range params.List() {
+			n, err := strconv.Atoi(version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid UUID version %q", version)
+			}
+			versions = append(versions, n)
+		}
+		return stringRule(IsUUIDRule(versions)), nil
+	})
+
+	registry.MustRegister("credit_card", func(params govalid.Params) (govalid.ValidationRule, error) {
+		var brands []CardBrand
+		for _, brand := range params.List() {
+			brands = append(brands, CardBrand(brand))
+		}
+		return stringRule(IsCreditCardRule(brands)), nil
+	})
+
+	registry.MustRegister("eu_vat", func(params govalid.Params) (govalid.ValidationRule, error) {
+		return stringRule(IsEUVATRule(params.List())), nil
+	})
+
+	registry.MustRegister("iban", func(params govalid.Params) (govalid.ValidationRule, error) {
+		countries := params.List()
+		if len(countries) == 1 && strings.EqualFold(countries[0], "sepa") {
+			countries = SEPACountries
+		}
+		return stringRule(IsIBANRule(countries)), nil
+	})
+
+	registry.MustRegister("bic", func(params govalid.Params) (govalid.ValidationRule, error) {
+		return stringRule(IsBICRule(params.List())), nil
+	})
+
+	registry.MustRegister("phone", func(params govalid.Params) (govalid.ValidationRule, error) {
+		return stringRule(IsPhoneRule(PhoneOptions{DefaultCountry: params.Raw()})), nil
+	})
+
+	registry.MustRegister("mobile_phone", func(params govalid.Params) (govalid.ValidationRule, error) {
+		return stringRule(IsPhoneRule(PhoneOptions{DefaultCountry: params.Raw(), Types: []PhoneType{PhoneMobile}})), nil
+	})
+
+	registry.MustRegister("postal_code", func(params govalid.Params) (govalid.ValidationRule, error) {
+		other, ok := params.Lookup(params.Raw())
+		if !ok {
+			return nil, fmt.Errorf("unknown field %s", params.Raw())
+		}
+		return stringRule(PostalCodeFieldRule(params.Raw(), other)), nil
+	})
+
+	stringRules := map[string]func(customMessage ...string) govalid.ValidationRule{
+		"hostname":  IsHostnameRule,
+		"ip":        IsIPRule,
+		"ipv4":      IsIPv4Rule,
+		"ipv6":      IsIPv6Rule,
+		"cidr":      IsCIDRRule,
+		"mac":       IsMACRule,
+		"host_port": IsHostPortRule,
+		"ulid":      IsULIDRule,
+		"isbn":      IsISBNRule,
+		"isbn10":    IsISBN10Rule,
+		"isbn13":    IsISBN13Rule,
+		"ean8":      IsEAN8Rule,
+		"ean13":     IsEAN13Rule,
+		"upc_a":     IsUPCARule,
+
+		"codice_fiscale": IsCodiceFiscaleRule,
+		"partita_iva":    IsPartitaIVARule,
+	}
+	for name, rule := range stringRules {
+		registry.MustRegister(name, func(govalid.Params) (govalid.ValidationRule, error) {
+			return stringRule(rule()), nil
+		})
+	}
+}
+
+// Values can be of any numeric type, so they are compared as float64
+// Other values fail with a CodeUnsupportedType error, i.e. min=3 on a string
+func numericRule(validator func(field string, value float64) govalid.ValidationFunc) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		v, ok := utils.ToFloat64(value)
+		if !ok {
+			return func() *internal.ValidationError {
+				return internal.NewUnsupportedTypeError(field, value, "number")
+			}
+		}
+		return validator(field, v)
+	}
+}
+
+func stringRule(rule govalid.ValidationRule) govalid.ValidationRule {
+	return func(field string, value any) govalid.ValidationFunc {
+		return rule(field, utils.NormalizeString(value))
+	}
+}
+
+// Parses "Field value" into a predicate comparing the other field with value
+func fieldEquals(params govalid.Params) (func() bool, error) {
+	name, expected, ok := strings.Cut(params.Raw(), " ")
+	if !ok {
+		return nil, fmt.Errorf("expected \"Field value\", got %q", params.Raw())
+	}
+
+	other, ok := params.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown field %s", name)
+	}
+
+	return func() bool {
+		return other != nil && fmt.Sprint(other) == expected
+	}, nil
+}
@@ -0,0 +1,89 @@
+// Package typed provides the built-in rules as govalid.TypedRule, for use with govalid.GroupOf
+//
+//	govalid.GroupOf("name", person.Name,
+//		typed.NonEmpty(),
+//		typed.MinLength(3),
+//	)
+//
+//	govalid.GroupOf("age", person.Age,
+//		typed.Min(18),
+//	)
+package typed
+
+import (
+	"github.com/Palma99/govalid"
+	"github.com/Palma99/govalid/internal"
+	"github.com/Palma99/govalid/validators"
+)
+
+// Allows to define custom validation logic for values of type T
+func Custom[T any](validate func(value T) *string, customMessage ...string) govalid.TypedRule[T] {
+	return func(field string, value T) govalid.ValidationFunc {
+		return validators.CustomValidator(validate)(field, value, customMessage...)
+	}
+}
+
+func NonEmpty(customMessage ...string) govalid.TypedRule[string] {
+	return func(field string, value string) govalid.ValidationFunc {
+		return validators.NonEmpty(field, value, customMessage...)
+	}
+}
+
+// Checks that a slice has at least one element
+func NonEmptySlice[E any](customMessage ...string) govalid.TypedRule[[]E] {
+	return func(field string, value []E) govalid.ValidationFunc {
+		return validators.NonEmpty(field, value, customMessage...)
+	}
+}
+
+func MinLength(min int, customMessage ...string) govalid.TypedRule[string] {
+	return func(field string, value string) govalid.ValidationFunc {
+		return validators.MinLength(field, value, min, customMessage...)
+	}
+}
+
+func MaxLength(max int, customMessage ...string) govalid.TypedRule[string] {
+	return func(field string, value string) govalid.ValidationFunc {
+		return validators.MaxLength(field, value, max, customMessage...)
+	}
+}
+
+// Checks the number of elements of a slice
+func MinItems[E any](min int, customMessage ...string) govalid.TypedRule[[]E] {
+	return func(field string, value []E) govalid.ValidationFunc {
+		return validators.MinLength(field, value, min, customMessage...)
+	}
+}
+
+// Checks the number of elements of a slice
+func MaxItems[E any](max int, customMessage ...string) govalid.TypedRule[[]E] {
+	return func(field string, value []E) govalid.ValidationFunc {
+		return validators.MaxLength(field, value, max, customMessage...)
+	}
+}
+
+// The type of the value is inferred from min, i.e. Min(int64(18)) for int64 values
+func Min[T internal.Number](min T, customMessage ...string) govalid.TypedRule[T] {
+	return func(field string, value T) govalid.ValidationFunc {
+		return validators.Min(field, value, min, customMessage...)
+	}
+}
+
+// The type of the value is inferred from max, i.e. Max(int64(120)) for int64 values
+func Max[T internal.Number](max T, customMessage ...string) govalid.TypedRule[T] {
+	return func(field string, value T) govalid.ValidationFunc {
+		return validators.Max(field, value, max, customMessage...)
+	}
+}
+
+func MatchesRegex(pattern string, customMessage ...string) govalid.TypedRule[string] {
+	return func(field string, value string) govalid.ValidationFunc {
+		return validators.MatchesRegex(field, value, pattern, customMessage...)
+	}
+}
+
+func IsEmail(customMessage ...string) govalid.TypedRule[string] {
+	return func(field string, value string) govalid.ValidationFunc {
+		return validators.IsEmail(field, value, customMessage...)
+	}
+}
@@ -0,0 +1,302 @@
+package validators
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type vatFormat struct {
+	// Format of the number without the country prefix
+	pattern *regexp.Regexp
+	// Verifies the check digits of a number matching pattern, nil if the country defines none
+	check func(number string) bool
+}
+
+// VAT number formats of the EU member states, see the VIES specifications
+var vatFormats = map[string]vatFormat{
+	"AT": {regexp.MustCompile(`^U\d{8}$`), vatAT},
+	"BE": {regexp.MustCompile(`^[01]?\d{9}$`), vatBE},
+	"BG": {regexp.MustCompile(`^\d{9,10}$`), vatBG},
+	"CY": {regexp.MustCompile(`^[0-59]\d{7}[A-Z]$`), vatCY},
+	"CZ": {regexp.MustCompile(`^\d{8,10}$`), vatCZ},
+	"DE": {regexp.MustCompile(`^[1-9]\d{8}$`), mod11Mod10},
+	"DK": {regexp.MustCompile(`^[1-9]\d{7}$`), vatDK},
+	"EE": {regexp.MustCompile(`^10\d{7}$`), vatEE},
+	"EL": {regexp.MustCompile(`^\d{9}$`), vatEL},
+	"ES": {regexp.MustCompile(`^[0-9A-Z]\d{7}[0-9A-Z]$`), vatES},
+	"FI": {regexp.MustCompile(`^\d{8}$`), vatFI},
+	"FR": {regexp.MustCompile(`^[0-9A-HJ-NP-Z]{2}\d{9}$`), vatFR},
+	"HR": {regexp.MustCompile(`^\d{11}$`), mod11Mod10},
+	"HU": {regexp.MustCompile(`^\d{8}$`), vatHU},
+	"IE": {regexp.MustCompile(`^(\d{7}[A-W][A-IW]?|\d[A-Z+*]\d{5}[A-W])$`), vatIE},
+	"IT": {regexp.MustCompile(`^\d{7}(00[1-9]|0[1-9]\d|100|12[01]|888|999)\d$`), validLuhn},
+	"LT": {regexp.MustCompile(`^(\d{7}1\d|\d{10}1\d)$`), vatLT},
+	"LU": {regexp.MustCompile(`^\d{8}$`), vatLU},
+	"LV": {regexp.MustCompile(`^\d{11}$`), nil},
+	"MT": {regexp.MustCompile(`^[1-9]\d{7}$`), vatMT},
+	"NL": {regexp.MustCompile(`^\d{9}B\d{2}$`), vatNL},
+	"PL": {regexp.MustCompile(`^\d{10}$`), vatPL},
+	"PT": {regexp.MustCompile(`^[1-9]\d{8}$`), vatPT},
+	"RO": {regexp.MustCompile(`^[1-9]\d{1,9}$`), vatRO},
+	"SE": {regexp.MustCompile(`^\d{10}01$`), vatSE},
+	"SI": {regexp.MustCompile(`^[1-9]\d{7}$`), vatSI},
+	"SK": {regexp.MustCompile(`^[1-9]\d[2-47-9]\d{7}$`), vatSK},
+}
+
+func sortedVATCountries() []string {
+	countries := make([]string, 0, len(vatFormats))
+	for country := range vatFormats {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+	return countries
+}
+
+func digitsOf(number string) []int {
+	digits := make([]int, len(number))
+	for i := range number {
+		digits[i] = int(number[i] - '0')
+	}
+	return digits
+}
+
+func weightedSum(digits []int, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += digits[i] * w
+	}
+	return sum
+}
+
+// ISO 7064 MOD 11,10, used by Germany and Croatia
+func mod11Mod10(number string) bool {
+	digits := digitsOf(number)
+	product := 10
+	for _, d := range digits[:len(digits)-1] {
+		sum := (d + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (2 * sum) % 11
+	}
+	return (11-product)%10 == digits[len(digits)-1]
+}
+
+func vatAT(number string) bool {
+	d := digitsOf(number[1:])
+	sum := d[0] + d[2] + d[4] + d[6]
+	for _, x := range []int{d[1], d[3], d[5]} {
+		sum += x/5 + (x*2)%10
+	}
+	return (10-(sum+4)%10)%10 == d[7]
+}
+
+// Old numbers have 9 digits, a leading 0 is added
+func vatBE(number string) bool {
+	if len(number) == 9 {
+		number = "0" + number
+	}
+	n, _ := strconv.Atoi(number[:8])
+	check, _ := strconv.Atoi(number[8:])
+	return 97-n%97 == check
+}
+
+// Only companies (9 digits) have a check digit, 10 digit numbers of people are not verified
+func vatBG(number string) bool {
+	if len(number) == 10 {
+		return true
+	}
+
+	d := digitsOf(number)
+	r := weightedSum(d, 1, 2, 3, 4, 5, 6, 7, 8) % 11
+	if r == 10 {
+		r = weightedSum(d, 3, 4, 5, 6, 7, 8, 9, 10) % 11 % 10
+	}
+	return r == d[8]
+}
+
+func vatCY(number string) bool {
+	odd := []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	if strings.HasPrefix(number, "12") {
+		return false
+	}
+
+	d := digitsOf(number[:8])
+	sum := odd[d[0]] + d[1] + odd[d[2]] + d[3] + odd[d[4]] + d[5] + odd[d[6]] + d[7]
+	return byte('A'+sum%26) == number[8]
+}
+
+// Only legal entities (8 digits) have a check digit, numbers of people are not verified
+func vatCZ(number string) bool {
+	if len(number) != 8 {
+		return true
+	}
+
+	d := digitsOf(number)
+	return (11-weightedSum(d, 8, 7, 6, 5, 4, 3, 2)%11)%10 == d[7]
+}
+
+func vatDK(number string) bool {
+	return weightedSum(digitsOf(number), 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+func vatEE(number string) bool {
+	d := digitsOf(number)
+	return (10-weightedSum(d, 3, 7, 1, 3, 7, 1, 3, 7)%10)%10 == d[8]
+}
+
+func vatEL(number string) bool {
+	d := digitsOf(number)
+	return weightedSum(d, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == d[8]
+}
+
+const nifLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// Spanish numbers are a NIF of a person, a NIE of a foreigner starting with X, Y or Z,
+// a NIF starting with K, L or M, or a CIF of a company starting with another letter
+func vatES(number string) bool {
+	switch first := number[0]; {
+	case first >= '0' && first <= '9':
+		n, _ := strconv.Atoi(number[:8])
+		return nifLetters[n%23] == number[8]
+	case strings.IndexByte("XYZ", first) >= 0:
+		n, _ := strconv.Atoi(strconv.Itoa(strings.IndexByte("XYZ", first)) + number[1:8])
+		return nifLetters[n%23] == number[8]
+	case strings.IndexByte("KLM", first) >= 0:
+		n, _ := strconv.Atoi(number[1:8])
+		return nifLetters[n%23] == number[8]
+	case strings.IndexByte("ABCDEFGHJNPQRSUVW", first) >= 0:
+		d := digitsOf(number[1:8])
+		sum := d[1] + d[3] + d[5]
+		for _, x := range []int{d[0], d[2], d[4], d[6]} {
+			sum += (2*x)/10 + (2*x)%10
+		}
+		check := (10 - sum%10) % 10
+		return number[8] == byte('0'+check) || number[8] == "JABCDEFGHI"[check]
+	default:
+		return false
+	}
+}
+
+func vatFI(number string) bool {
+	d := digitsOf(number)
+	r := weightedSum(d, 7, 9, 10, 5, 8, 4, 2) % 11
+	if r == 1 {
+		return false
+	}
+	return (11-r)%11 == d[7]
+}
+
+// The key is numeric for older numbers only, newer alphanumeric keys are not verified
+func vatFR(number string) bool {
+	key, err := strconv.Atoi(number[:2])
+	if err != nil {
+		return true
+	}
+	siren, _ := strconv.Atoi(number[2:])
+	return key == (12+3*(siren%97))%97
+}
+
+func vatHU(number string) bool {
+	d := digitsOf(number)
+	return (10-weightedSum(d, 9, 7, 3, 1, 9, 7, 3)%10)%10 == d[7]
+}
+
+// Old numbers have a letter or symbol in the second position, they are converted to the
+// current format before computing the check letter
+func vatIE(number string) bool {
+	if number[1] < '0' || number[1] > '9' {
+		number = "0" + number[2:7] + number[:1] + number[7:]
+	}
+
+	sum := weightedSum(digitsOf(number[:7]), 8, 7, 6, 5, 4, 3, 2)
+	if len(number) == 9 && number[8] != 'W' {
+		sum += 9 * int(number[8]-'A'+1)
+	}
+	return "WABCDEFGHIJKLMNOPQRSTUV"[sum%23] == number[7]
+}
+
+func vatLT(number string) bool {
+	d := digitsOf(number)
+	n := len(d) - 1
+
+	sum := 0
+	for i := 0; i < n; i++ {
+		sum += d[i] * (1 + i%9)
+	}
+	r := sum % 11
+	if r == 10 {
+		sum = 0
+		for i := 0; i < n; i++ {
+			sum += d[i] * (1 + (i+2)%9)
+		}
+		r = sum % 11 % 10
+	}
+	return r == d[n]
+}
+
+func vatLU(number string) bool {
+	n, _ := strconv.Atoi(number[:6])
+	check, _ := strconv.Atoi(number[6:])
+	return n%89 == check
+}
+
+func vatMT(number string) bool {
+	check, _ := strconv.Atoi(number[6:])
+	return 37-weightedSum(digitsOf(number[:6]), 3, 4, 6, 7, 8, 9)%37 == check
+}
+
+// Numbers of sole traders, issued since 2020, use ISO 7064 MOD 97-10 on the whole
+// number with the country prefix, the others the eleven test on the first 9 digits
+func vatNL(number string) bool {
+	remainder := 0
+	for _, c := range "2321" + strings.Replace(number, "B", "11", 1) {
+		remainder = (remainder*10 + int(c-'0')) % 97
+	}
+	if remainder == 1 {
+		return true
+	}
+
+	d := digitsOf(number[:9])
+	return weightedSum(d, 9, 8, 7, 6, 5, 4, 3, 2)%11 == d[8]
+}
+
+func vatPL(number string) bool {
+	d := digitsOf(number)
+	return weightedSum(d, 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == d[9]
+}
+
+func vatPT(number string) bool {
+	d := digitsOf(number)
+	check := 11 - weightedSum(d, 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if check > 9 {
+		check = 0
+	}
+	return check == d[8]
+}
+
+// Numbers have 2 to 10 digits, they are padded with zeros to compute the check digit
+func vatRO(number string) bool {
+	d := digitsOf(strings.Repeat("0", 10-len(number)) + number)
+	return weightedSum(d, 7, 5, 3, 2, 1, 7, 5, 3, 2)*10%11%10 == d[9]
+}
+
+func vatSE(number string) bool {
+	return validLuhn(number[:10])
+}
+
+func vatSI(number string) bool {
+	d := digitsOf(number)
+	check := 11 - weightedSum(d, 8, 7, 6, 5, 4, 3, 2)%11
+	if check == 11 {
+		return false
+	}
+	return check%10 == d[7]
+}
+
+func vatSK(number string) bool {
+	n, _ := strconv.ParseInt(number, 10, 64)
+	return n%11 == 0
+}